@@ -0,0 +1,229 @@
+package main
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+//defaultAuditPageSize - the number of events returned per page when the caller does not specify a page size
+const defaultAuditPageSize = 100
+
+//maxAuditPageSize - the largest page of events which can be requested
+const maxAuditPageSize = 1000
+
+//maxAuditEventsInMemory - the number of the most recent events which are held in memory to be listed. Older events are only kept in the sink
+const maxAuditEventsInMemory = 10000
+
+//ErrInvalidPageToken - returned when a page token was not produced by a previous call
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+//AuditWordChange - the state of a word before and after an audited mutation
+type AuditWordChange struct {
+	Word          string `json:"word"`
+	ExistedBefore bool   `json:"existedBefore"`
+	ExistsAfter   bool   `json:"existsAfter"`
+}
+
+//AuditEvent - a record of a mutation made to a dictionary
+type AuditEvent struct {
+	ID   int64     `json:"id"`
+	Time time.Time `json:"time"`
+	//Caller - the user-id the client sent with the request, or its peer address without one. It is not authenticated, unlike TenantID
+	Caller      string `json:"caller"`
+	PeerAddress string `json:"peerAddress"`
+	RequestID   string `json:"requestId"`
+	TenantID    string `json:"tenantId"`
+	Dictionary  string `json:"dictionary"`
+	Action      string `json:"action"`
+	//Version - the dictionary version the mutation produced. The state before the mutation is Version - 1. 0 if the mutation failed
+	Version int64             `json:"version"`
+	Changes []AuditWordChange `json:"changes"`
+	Error   string            `json:"error,omitempty"`
+}
+
+//AuditEventFilter - restricts the events returned by ListAuditEvents. Zero values do not restrict the events
+type AuditEventFilter struct {
+	TenantID   string
+	Dictionary string
+	Caller     string
+	Action     string
+	Word       string
+	Since      time.Time
+	Until      time.Time
+}
+
+//matches - reports whether event passes the filter
+func (filter AuditEventFilter) matches(event *AuditEvent) bool {
+	if filter.TenantID != "" && filter.TenantID != event.TenantID {
+		return false
+	}
+	if filter.Dictionary != "" && filter.Dictionary != event.Dictionary {
+		return false
+	}
+	if filter.Caller != "" && filter.Caller != event.Caller {
+		return false
+	}
+	if filter.Action != "" && filter.Action != event.Action {
+		return false
+	}
+	if !filter.Since.IsZero() && event.Time.Before(filter.Since) {
+		return false
+	}
+	if !filter.Until.IsZero() && !event.Time.Before(filter.Until) {
+		return false
+	}
+	if filter.Word != "" {
+		for _, change := range event.Changes {
+			if change.Word == filter.Word {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+//AuditLog - an append-only log of dictionary mutations. The most recent events are held in memory and, optionally, every event is written to a sink as JSONL
+type AuditLog struct {
+	mutex sync.RWMutex
+	//events - the most recent events, in ID order
+	events []*AuditEvent
+	lastID int64
+	sink   io.Writer
+	now    func() time.Time
+}
+
+//NewAuditLog - creates a new AuditLog. Each event is also written to sink, unless sink is nil
+func NewAuditLog(sink io.Writer) *AuditLog {
+	newAuditLog := new(AuditLog)
+	newAuditLog.events = make([]*AuditEvent, 0)
+	newAuditLog.sink = sink
+	newAuditLog.now = time.Now
+	return newAuditLog
+}
+
+//OpenAuditLogFile - creates a new AuditLog which appends each event to the JSONL file at path.
+// The events already in the file are loaded, so that IDs carry on from the last of them. The caller must close the returned file
+func OpenAuditLogFile(path string) (*AuditLog, *os.File, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to open audit log")
+	}
+	newAuditLog := NewAuditLog(file)
+	reader := bufio.NewReader(file)
+	for lineNumber := 1; ; lineNumber++ {
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 0 {
+			event := new(AuditEvent)
+			if jsonErr := json.Unmarshal(line, event); jsonErr != nil {
+				file.Close()
+				return nil, nil, errors.Wrap(jsonErr, fmt.Sprintf("failed to load audit log line %d", lineNumber))
+			}
+			newAuditLog.keep(event)
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			file.Close()
+			return nil, nil, errors.Wrap(err, "failed to load audit log")
+		}
+	}
+	return newAuditLog, file, nil
+}
+
+//Append - records event, assigning its ID and Time. The event is only recorded once it has been written to the sink
+func (auditLog *AuditLog) Append(event AuditEvent) error {
+	auditLog.mutex.Lock()
+	defer auditLog.mutex.Unlock()
+
+	event.ID = auditLog.lastID + 1
+	event.Time = auditLog.now()
+
+	if auditLog.sink != nil {
+		line, err := json.Marshal(&event)
+		if err != nil {
+			return err
+		}
+		if _, err = auditLog.sink.Write(append(line, '\n')); err != nil {
+			return errors.Wrap(err, "failed to write audit event")
+		}
+	}
+	auditLog.keep(&event)
+	return nil
+}
+
+//keep - holds event in memory, letting go of the oldest event once there are too many. The caller must hold the mutex
+func (auditLog *AuditLog) keep(event *AuditEvent) {
+	auditLog.events = append(auditLog.events, event)
+	if event.ID > auditLog.lastID {
+		auditLog.lastID = event.ID
+	}
+	if len(auditLog.events) > maxAuditEventsInMemory {
+		auditLog.events[0] = nil
+		auditLog.events = auditLog.events[1:]
+	}
+}
+
+//ListAuditEvents - returns a page of the events held in memory which pass filter, oldest first.
+// pageToken is "" for the first page, and the returned nextPageToken for the following pages. nextPageToken is "" on the last page
+func (auditLog *AuditLog) ListAuditEvents(filter AuditEventFilter, pageSize int, pageToken string) (events []AuditEvent, nextPageToken string, err error) {
+	if pageSize <= 0 {
+		pageSize = defaultAuditPageSize
+	}
+	if pageSize > maxAuditPageSize {
+		pageSize = maxAuditPageSize
+	}
+	startID, err := decodeAuditPageToken(pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	auditLog.mutex.RLock()
+	defer auditLog.mutex.RUnlock()
+
+	//The page starts at the first event from startID, which stays put as older events are let go of
+	start := sort.Search(len(auditLog.events), func(i int) bool { return auditLog.events[i].ID >= startID })
+	events = make([]AuditEvent, 0, pageSize)
+	for i := start; i < len(auditLog.events); i++ {
+		if !filter.matches(auditLog.events[i]) {
+			continue
+		}
+		if len(events) == pageSize {
+			return events, encodeAuditPageToken(auditLog.events[i].ID), nil
+		}
+		events = append(events, *auditLog.events[i])
+	}
+	return events, "", nil
+}
+
+//encodeAuditPageToken - creates an opaque page token for the page starting at the event with ID startID
+func encodeAuditPageToken(startID int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(startID, 10)))
+}
+
+//decodeAuditPageToken - returns the ID of the event a page token starts at
+func decodeAuditPageToken(pageToken string) (startID int64, err error) {
+	if pageToken == "" {
+		return 0, nil
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return 0, ErrInvalidPageToken
+	}
+	startID, err = strconv.ParseInt(string(decoded), 10, 64)
+	if err != nil || startID < 0 {
+		return 0, ErrInvalidPageToken
+	}
+	return startID, nil
+}
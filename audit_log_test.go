@@ -0,0 +1,146 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//failingWriter - an audit log sink which refuses every write
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("disk full")
+}
+
+func TestAuditLog(t *testing.T) {
+	t.Run("basic test", func(t *testing.T) {
+		var sink bytes.Buffer
+		now := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+		auditLog := NewAuditLog(&sink)
+		auditLog.now = func() time.Time { return now }
+
+		//it should assign ids and times to events
+		auditLog.Append(AuditEvent{Caller: "alice", TenantID: "acme", Action: "AddWords", Version: 2, Changes: []AuditWordChange{{Word: "apple", ExistsAfter: true}}})
+		events, nextPageToken, err := auditLog.ListAuditEvents(AuditEventFilter{}, 0, "")
+		assert.NoError(t, err)
+		assert.EqualValues(t, "", nextPageToken)
+		if assert.Len(t, events, 1) {
+			assert.EqualValues(t, 1, events[0].ID)
+			assert.EqualValues(t, now, events[0].Time)
+		}
+
+		//it should write each event to the sink as a line of json
+		assert.EqualValues(t, 1, strings.Count(sink.String(), "\n"))
+		assert.Contains(t, sink.String(), `"caller":"alice"`)
+	})
+	t.Run("filters and pages", func(t *testing.T) {
+		auditLog := NewAuditLog(nil)
+		for _, caller := range []string{"alice", "bob", "alice", "alice", "bob", "alice"} {
+			auditLog.Append(AuditEvent{Caller: caller, Action: "AddWords"})
+		}
+
+		//it should page through the events which pass the filter
+		filter := AuditEventFilter{Caller: "alice"}
+		ids := make([]int64, 0)
+		pageToken := ""
+		for page := 0; page < 10; page++ {
+			events, nextPageToken, err := auditLog.ListAuditEvents(filter, 3, pageToken)
+			assert.NoError(t, err)
+			assert.True(t, len(events) <= 3)
+			for _, event := range events {
+				ids = append(ids, event.ID)
+			}
+			if nextPageToken == "" {
+				break
+			}
+			pageToken = nextPageToken
+		}
+		assert.EqualValues(t, []int64{1, 3, 4, 6}, ids)
+
+		//it should refuse page tokens it did not produce
+		_, _, err := auditLog.ListAuditEvents(filter, 3, "not a token")
+		assert.Error(t, err)
+	})
+	t.Run("word filter", func(t *testing.T) {
+		auditLog := NewAuditLog(nil)
+		auditLog.Append(AuditEvent{Changes: []AuditWordChange{{Word: "apple"}}})
+		auditLog.Append(AuditEvent{Changes: []AuditWordChange{{Word: "pear"}, {Word: "plum"}}})
+
+		events, _, _ := auditLog.ListAuditEvents(AuditEventFilter{Word: "plum"}, 0, "")
+		if assert.Len(t, events, 1) {
+			assert.EqualValues(t, 2, events[0].ID)
+		}
+	})
+	t.Run("sink failure", func(t *testing.T) {
+		auditLog := NewAuditLog(failingWriter{})
+
+		//it should not keep an event which could not be written to the sink, nor use up its id
+		assert.Error(t, auditLog.Append(AuditEvent{Action: "AddWords"}))
+		events, _, _ := auditLog.ListAuditEvents(AuditEventFilter{}, 0, "")
+		assert.Len(t, events, 0)
+		auditLog.sink = nil
+		auditLog.Append(AuditEvent{Action: "AddWords"})
+		events, _, _ = auditLog.ListAuditEvents(AuditEventFilter{}, 0, "")
+		if assert.Len(t, events, 1) {
+			assert.EqualValues(t, 1, events[0].ID)
+		}
+	})
+	t.Run("memory limit", func(t *testing.T) {
+		auditLog := NewAuditLog(nil)
+		for i := 0; i < maxAuditEventsInMemory; i++ {
+			auditLog.Append(AuditEvent{Action: "AddWords"})
+		}
+		_, pageToken, _ := auditLog.ListAuditEvents(AuditEventFilter{}, 2, "")
+
+		//it should let go of the oldest events once too many are held
+		auditLog.Append(AuditEvent{Action: "AddWords"})
+		auditLog.Append(AuditEvent{Action: "AddWords"})
+		events, _, _ := auditLog.ListAuditEvents(AuditEventFilter{}, 1, "")
+		if assert.Len(t, events, 1) {
+			assert.EqualValues(t, 3, events[0].ID)
+		}
+
+		//it should carry on a listing from the events which are still held
+		events, _, _ = auditLog.ListAuditEvents(AuditEventFilter{}, 1, pageToken)
+		if assert.Len(t, events, 1) {
+			assert.EqualValues(t, 3, events[0].ID)
+		}
+	})
+	t.Run("file test", func(t *testing.T) {
+		dir, _ := ioutil.TempDir("", "audit_log")
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "audit.jsonl")
+		auditLog, file, err := OpenAuditLogFile(path)
+		assert.NoError(t, err)
+		auditLog.Append(AuditEvent{Caller: "alice", Action: "AddWords"})
+		auditLog.Append(AuditEvent{Caller: "bob", Action: "AddWords"})
+		file.Close()
+
+		//it should load the events already in the file, and carry on their ids
+		auditLog, file, err = OpenAuditLogFile(path)
+		assert.NoError(t, err)
+		auditLog.Append(AuditEvent{Caller: "carol", Action: "AddWords"})
+		file.Close()
+		events, _, _ := auditLog.ListAuditEvents(AuditEventFilter{}, 0, "")
+		if assert.Len(t, events, 3) {
+			assert.EqualValues(t, []string{"alice", "bob", "carol"}, []string{events[0].Caller, events[1].Caller, events[2].Caller})
+			assert.EqualValues(t, 3, events[2].ID)
+		}
+		contents, _ := ioutil.ReadFile(path)
+		assert.EqualValues(t, 3, strings.Count(string(contents), "\n"))
+		assert.Contains(t, string(contents), `"id":3,`)
+
+		//it should refuse a file which is not an audit log
+		ioutil.WriteFile(path, []byte("not json\n"), 0644)
+		_, _, err = OpenAuditLogFile(path)
+		assert.Error(t, err)
+	})
+}
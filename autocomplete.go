@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+//searchCount - returns the number of times lowercaseWord has been searched for. The caller must hold the mutex
+func (wordDictionary *WordDictionary) searchCount(lowercaseWord string) int64 {
+	if stat := wordDictionary.keyWordStatsMap[lowercaseWord]; stat != nil {
+		return stat.numberOfTimesSearched
+	}
+	return 0
+}
+
+//checkAutocompleteBlendWeight - returns an error if blendWeight is not between 0 and 1
+func checkAutocompleteBlendWeight(blendWeight float64) error {
+	if !(blendWeight >= 0 && blendWeight <= 1) {
+		return errors.New(fmt.Sprintf("autocomplete blend weight must be between 0 and 1, got %v", blendWeight))
+	}
+	return nil
+}
+
+//SetAutocompleteBlendWeight - sets the share of an autocomplete suggestion's score which comes from how often it was searched, from 0 to 1.
+// The rest comes from the brevity of the word, so that short words are suggested first among words searched equally often
+func (wordDictionary *WordDictionary) SetAutocompleteBlendWeight(blendWeight float64) error {
+	if err := checkAutocompleteBlendWeight(blendWeight); err != nil {
+		return err
+	}
+
+	wordDictionary.mutex.Lock()
+	defer wordDictionary.mutex.Unlock()
+
+	//Every score changes, so the trie is built again
+	completions := newCompletionTrie(blendWeight)
+	for word := range wordDictionary.dictionaryWords {
+		completions.set(word, wordDictionary.searchCount(word))
+	}
+	wordDictionary.completions = completions
+	return nil
+}
+
+//Autocomplete - returns up to limit dictionary words starting with prefix, the most searched for first.
+// At most completionTopK words are returned, and a limit of 0 or less returns as many as that. Autocompleting does not count as searching for prefix
+func (wordDictionary *WordDictionary) Autocomplete(prefix string, limit int) []Completion {
+	if limit <= 0 || limit > completionTopK {
+		limit = completionTopK
+	}
+	lowercasePrefix := normalizeWord(prefix)
+
+	wordDictionary.mutex.Lock()
+	defer wordDictionary.mutex.Unlock()
+
+	//Expired words stay in the trie until they are swept, and blocked words stay in it in case they are unblocked
+	return wordDictionary.completions.completeWhere(lowercasePrefix, limit, wordDictionary.isKnownWord)
+}
+
+//SetAutocompleteBlendWeight - sets the autocomplete blend weight of each dictionary, including dictionaries created later.
+// See WordDictionary.SetAutocompleteBlendWeight
+func (wordSearchService *WordSearchService) SetAutocompleteBlendWeight(blendWeight float64) error {
+	if err := checkAutocompleteBlendWeight(blendWeight); err != nil {
+		return err
+	}
+
+	wordSearchService.mutex.Lock()
+	defer wordSearchService.mutex.Unlock()
+
+	wordSearchService.autocompleteBlendWeight = blendWeight
+	for _, dictionary := range wordSearchService.dictionaries {
+		dictionary.SetAutocompleteBlendWeight(blendWeight)
+	}
+	return nil
+}
+
+//SetAutocompleteBlendWeight - sets the autocomplete blend weight each tenant starts with. Only tenants created afterwards are affected
+func (tenantRegistry *TenantRegistry) SetAutocompleteBlendWeight(blendWeight float64) error {
+	if err := checkAutocompleteBlendWeight(blendWeight); err != nil {
+		return err
+	}
+
+	tenantRegistry.mutex.Lock()
+	defer tenantRegistry.mutex.Unlock()
+
+	tenantRegistry.autocompleteBlendWeight = blendWeight
+	return nil
+}
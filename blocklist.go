@@ -0,0 +1,129 @@
+package main
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+//Blocklist - a managed list of terms which may not be added to a dictionary, and which are hidden from search results and keyword rankings.
+// A phrase is blocked when it is a blocked term or contains the tokens of one in a row, e.g. "bad" blocks "bad cream"
+type Blocklist struct {
+	mutex sync.RWMutex
+	terms map[string]bool
+	//tokenTerms - the number of blocked terms with the tokens of each key, joined by spaces
+	tokenTerms map[string]int
+	//maxTermTokens - the most tokens any blocked term has
+	maxTermTokens int
+}
+
+//NewBlocklist - creates a new, empty Blocklist
+func NewBlocklist() *Blocklist {
+	newBlocklist := new(Blocklist)
+	newBlocklist.terms = make(map[string]bool)
+	newBlocklist.tokenTerms = make(map[string]int)
+	return newBlocklist
+}
+
+//termTokens - returns the tokens of term joined by spaces, and how many there are
+func termTokens(term string) (joinedTokens string, numberOfTokens int) {
+	tokens := tokenizeText(term)
+	words := make([]string, len(tokens))
+	for i := range tokens {
+		words[i] = tokens[i].word
+	}
+	return strings.Join(words, " "), len(words)
+}
+
+//AddTerms - blocks each of terms
+func (blocklist *Blocklist) AddTerms(terms []string) {
+	blocklist.mutex.Lock()
+	defer blocklist.mutex.Unlock()
+
+	for i := range terms {
+		term := normalizeWord(strings.TrimSpace(terms[i]))
+		if term == "" || blocklist.terms[term] {
+			continue
+		}
+		blocklist.terms[term] = true
+		if joinedTokens, numberOfTokens := termTokens(term); numberOfTokens > 0 {
+			blocklist.tokenTerms[joinedTokens]++
+			if numberOfTokens > blocklist.maxTermTokens {
+				blocklist.maxTermTokens = numberOfTokens
+			}
+		}
+	}
+}
+
+//RemoveTerms - unblocks each of terms
+func (blocklist *Blocklist) RemoveTerms(terms []string) {
+	blocklist.mutex.Lock()
+	defer blocklist.mutex.Unlock()
+
+	for i := range terms {
+		term := normalizeWord(strings.TrimSpace(terms[i]))
+		if !blocklist.terms[term] {
+			continue
+		}
+		delete(blocklist.terms, term)
+		if joinedTokens, numberOfTokens := termTokens(term); numberOfTokens > 0 {
+			//Several terms, such as "ice-cream" and "ice cream", share their tokens
+			blocklist.tokenTerms[joinedTokens]--
+			if blocklist.tokenTerms[joinedTokens] == 0 {
+				delete(blocklist.tokenTerms, joinedTokens)
+			}
+		}
+	}
+}
+
+//ListTerms - returns the blocked terms in alphabetical order
+func (blocklist *Blocklist) ListTerms() (terms []string) {
+	blocklist.mutex.RLock()
+	defer blocklist.mutex.RUnlock()
+
+	_terms := make([]string, 0, len(blocklist.terms))
+	for term := range blocklist.terms {
+		_terms = append(_terms, term)
+	}
+	sort.Strings(_terms)
+	return _terms
+}
+
+//IsBlocked - reports whether the lowercase word is blocked, either as a whole or by a run of its tokens. A nil Blocklist blocks nothing
+func (blocklist *Blocklist) IsBlocked(lowercaseWord string) bool {
+	if blocklist == nil {
+		return false
+	}
+
+	blocklist.mutex.RLock()
+	defer blocklist.mutex.RUnlock()
+
+	if blocklist.terms[lowercaseWord] {
+		return true
+	}
+	if len(blocklist.tokenTerms) == 0 {
+		return false
+	}
+	tokens := tokenizeText(lowercaseWord)
+	for start := range tokens {
+		run := ""
+		for end := start; end < len(tokens) && end-start < blocklist.maxTermTokens; end++ {
+			if end > start {
+				run += " "
+			}
+			run += tokens[end].word
+			if blocklist.tokenTerms[run] > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+//SetBlocklist - sets the blocklist applied to the dictionary
+func (wordDictionary *WordDictionary) SetBlocklist(blocklist *Blocklist) {
+	wordDictionary.mutex.Lock()
+	defer wordDictionary.mutex.Unlock()
+
+	wordDictionary.blocklist = blocklist
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlocklist(t *testing.T) {
+	t.Run("basic test", func(t *testing.T) {
+		blocklist := NewBlocklist()
+
+		//it should block terms regardless of case
+		blocklist.AddTerms([]string{"Darn", " heck ", ""})
+		assert.EqualValues(t, []string{"darn", "heck"}, blocklist.ListTerms())
+		assert.True(t, blocklist.IsBlocked("darn"))
+
+		//it should allow terms to be unblocked
+		blocklist.RemoveTerms([]string{"DARN"})
+		assert.EqualValues(t, []string{"heck"}, blocklist.ListTerms())
+		assert.False(t, blocklist.IsBlocked("darn"))
+	})
+	t.Run("phrase test", func(t *testing.T) {
+		blocklist := NewBlocklist()
+		blocklist.AddTerms([]string{"darn", "ice-cream", "ice cream"})
+
+		//it should block a phrase containing a blocked term as one of its tokens, but not a token which only starts with it
+		assert.True(t, blocklist.IsBlocked("darn cream"))
+		assert.True(t, blocklist.IsBlocked("oh-darn"))
+		assert.False(t, blocklist.IsBlocked("darning needle"))
+
+		//it should block a phrase containing the tokens of a blocked phrase in a row
+		assert.True(t, blocklist.IsBlocked("vanilla ice cream cone"))
+		assert.False(t, blocklist.IsBlocked("ice water cream"))
+
+		//it should keep blocking tokens shared by a term which is still blocked
+		blocklist.RemoveTerms([]string{"ice-cream"})
+		assert.True(t, blocklist.IsBlocked("ice-cream sundae"))
+		blocklist.RemoveTerms([]string{"ice cream"})
+		assert.False(t, blocklist.IsBlocked("ice-cream sundae"))
+	})
+	t.Run("dictionary test", func(t *testing.T) {
+		wordSearchService := NewWordSearchService()
+		wordSearchService.AddWords([]string{"darn", "darning"})
+		wordSearchService.Blocklist().AddTerms([]string{"darn"})
+
+		//it should refuse blocked words, and phrases containing them
+		wordSearchService.Blocklist().AddTerms([]string{"drat"})
+		assert.IsType(t, &WordValidationError{}, wordSearchService.AddWords([]string{"drat"}))
+		assert.IsType(t, &WordValidationError{}, wordSearchService.AddWords([]string{"drat cream"}))
+
+		//it should suppress blocked words already in the dictionary from search results
+		assert.EqualValues(t, []string{"darning"}, wordSearchService.SearchWord("dar"))
+
+		//it should hide blocked keywords from the rankings while still counting them
+		wordSearchService.SearchWord("darn")
+		wordSearchService.SearchWord("darn")
+		assert.EqualValues(t, []string{"dar"}, wordSearchService.Top5SearchKeyWords())
+		wordSearchService.Blocklist().RemoveTerms([]string{"darn"})
+		assert.EqualValues(t, []string{"darn", "dar"}, wordSearchService.Top5SearchKeyWords())
+
+		//it should apply the same blocklist to every dictionary
+		french, _ := wordSearchService.CreateDictionary("french")
+		assert.Error(t, french.AddWords([]string{"drat"}))
+	})
+}
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"math"
+	"sort"
+	"unicode/utf8"
+)
+
+//DefaultAutocompleteBlendWeight - the share of a completion's score which comes from popularity when the config does not say
+const DefaultAutocompleteBlendWeight = 0.8
+
+//completionTopK - the number of best completions cached at each node of a completionTrie, and so the most completions one lookup can return
+const completionTopK = 10
+
+//Completion - a word suggested to complete a prefix
+type Completion struct {
+	Word string
+	//SearchCount - the number of times the word has been searched for
+	SearchCount int64
+	//Score - the blend of the word's popularity and brevity the completions are ranked by
+	Score float64
+}
+
+//completionTrieNode - a node of a completionTrie, reached by the runes of a prefix
+type completionTrieNode struct {
+	children map[rune]*completionTrieNode
+	//own - the completion of the dictionary word ending at this node, if there is one
+	own *Completion
+	//top - the best completions of this node's prefix, best first
+	top []Completion
+}
+
+//completionTrie - the dictionary words in a trie in which every node caches the best completions of its prefix,
+// so that a lookup only walks the prefix rather than visiting every word below it.
+// When a word's score changes only the nodes on its path are updated
+type completionTrie struct {
+	root        *completionTrieNode
+	blendWeight float64
+}
+
+//newCompletionTrie - creates an empty completionTrie which ranks completions with blendWeight, see completionScore
+func newCompletionTrie(blendWeight float64) *completionTrie {
+	return &completionTrie{root: &completionTrieNode{}, blendWeight: blendWeight}
+}
+
+//completionScore - blends the popularity of word with its brevity. A blendWeight of 1 ranks by popularity only, 0 by brevity only
+func completionScore(word string, searchCount int64, blendWeight float64) float64 {
+	brevity := 1 / float64(utf8.RuneCountInString(word)+1)
+	return blendWeight*math.Log1p(float64(searchCount)) + (1-blendWeight)*brevity
+}
+
+//completionLess - reports whether completion a ranks before completion b
+func completionLess(a Completion, b Completion) bool {
+	if a.Score != b.Score {
+		return a.Score > b.Score
+	}
+	return a.Word < b.Word
+}
+
+//path - returns the nodes from the root to the node of word, creating them if create is set. nil is returned if the nodes do not exist
+func (trie *completionTrie) path(word string, create bool) []*completionTrieNode {
+	nodes := []*completionTrieNode{trie.root}
+	node := trie.root
+	for _, r := range word {
+		child := node.children[r]
+		if child == nil {
+			if !create {
+				return nil
+			}
+			if node.children == nil {
+				node.children = make(map[rune]*completionTrieNode)
+			}
+			child = &completionTrieNode{}
+			node.children[r] = child
+		}
+		nodes = append(nodes, child)
+		node = child
+	}
+	return nodes
+}
+
+//set - adds word to the trie, or updates its search count if it is already there
+func (trie *completionTrie) set(word string, searchCount int64) {
+	completion := Completion{Word: word, SearchCount: searchCount, Score: completionScore(word, searchCount, trie.blendWeight)}
+	nodes := trie.path(word, true)
+	leaf := nodes[len(nodes)-1]
+	previous := leaf.own
+	leaf.own = &completion
+	if previous != nil && completion.Score < previous.Score {
+		//A lower score may let a word which is not cached overtake this one, which only the children can tell
+		trie.rebuildPath(nodes)
+		return
+	}
+	for _, node := range nodes {
+		node.offer(completion)
+	}
+}
+
+//remove - removes word from the trie, along with the nodes which no longer lead to a word
+func (trie *completionTrie) remove(word string) {
+	nodes := trie.path(word, false)
+	if nodes == nil || nodes[len(nodes)-1].own == nil {
+		return
+	}
+	nodes[len(nodes)-1].own = nil
+	runes := []rune(word)
+	for len(nodes) > 1 {
+		leaf := nodes[len(nodes)-1]
+		if leaf.own != nil || len(leaf.children) > 0 {
+			break
+		}
+		nodes = nodes[:len(nodes)-1]
+		delete(nodes[len(nodes)-1].children, runes[len(nodes)-1])
+	}
+	trie.rebuildPath(nodes)
+}
+
+//rebuildPath - recomputes the cached completions of nodes, from the bottom up so that each node is rebuilt from children which are already up to date
+func (trie *completionTrie) rebuildPath(nodes []*completionTrieNode) {
+	for i := len(nodes) - 1; i >= 0; i-- {
+		nodes[i].rebuild()
+	}
+}
+
+//complete - returns the best completions of prefix, best first
+func (trie *completionTrie) complete(prefix string) []Completion {
+	return trie.completeWhere(prefix, completionTopK, func(string) bool { return true })
+}
+
+//completeWhere - returns up to limit of the best completions of prefix for which keep returns true, best first.
+// When the cached completions run short, the words below prefix are searched for the best ones which are kept
+func (trie *completionTrie) completeWhere(prefix string, limit int, keep func(word string) bool) []Completion {
+	nodes := trie.path(prefix, false)
+	if nodes == nil {
+		return []Completion{}
+	}
+	node := nodes[len(nodes)-1]
+	completions := make([]Completion, 0, limit)
+	for _, completion := range node.top {
+		if keep(completion.Word) {
+			completions = append(completions, completion)
+			if len(completions) == limit {
+				return completions
+			}
+		}
+	}
+	if len(node.top) < completionTopK {
+		//Every word below prefix was cached
+		return completions
+	}
+	completions = node.collect(completions[:0], keep)
+	sort.Slice(completions, func(i, j int) bool { return completionLess(completions[i], completions[j]) })
+	if len(completions) > limit {
+		completions = completions[:limit]
+	}
+	return completions
+}
+
+//collect - appends the completions of the words at and below the node for which keep returns true
+func (node *completionTrieNode) collect(completions []Completion, keep func(word string) bool) []Completion {
+	if node.own != nil && keep(node.own.Word) {
+		completions = append(completions, *node.own)
+	}
+	for _, child := range node.children {
+		completions = child.collect(completions, keep)
+	}
+	return completions
+}
+
+//offer - considers completion, whose score has not gone down, for the node's cached best completions
+func (node *completionTrieNode) offer(completion Completion) {
+	for i := range node.top {
+		if node.top[i].Word == completion.Word {
+			node.top = append(node.top[:i], node.top[i+1:]...)
+			break
+		}
+	}
+	i := sort.Search(len(node.top), func(i int) bool { return completionLess(completion, node.top[i]) })
+	if i == completionTopK {
+		return
+	}
+	node.top = append(node.top, Completion{})
+	copy(node.top[i+1:], node.top[i:])
+	node.top[i] = completion
+	if len(node.top) > completionTopK {
+		node.top = node.top[:completionTopK]
+	}
+}
+
+//rebuild - recomputes the node's cached best completions from its own word and its children's cached completions
+func (node *completionTrieNode) rebuild() {
+	candidates := make([]Completion, 0, completionTopK)
+	if node.own != nil {
+		candidates = append(candidates, *node.own)
+	}
+	for _, child := range node.children {
+		candidates = append(candidates, child.top...)
+	}
+	sort.Slice(candidates, func(i, j int) bool { return completionLess(candidates[i], candidates[j]) })
+	if len(candidates) > completionTopK {
+		candidates = candidates[:completionTopK]
+	}
+	node.top = candidates
+}
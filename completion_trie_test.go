@@ -0,0 +1,173 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//completionWords - returns the words of completions, in order
+func completionWords(completions []Completion) []string {
+	words := make([]string, len(completions))
+	for i := range completions {
+		words[i] = completions[i].Word
+	}
+	return words
+}
+
+func TestAutocomplete(t *testing.T) {
+	t.Run("popularity test", func(t *testing.T) {
+		wordDictionary := NewWordDictionary("test")
+		wordDictionary.AddWords([]string{"car", "card", "care", "cart", "carbon", "dog"})
+		for i := 0; i < 3; i++ {
+			wordDictionary.SearchWord("cart")
+		}
+		wordDictionary.SearchWord("carbon")
+
+		//it should suggest the most searched for words first, then the shortest
+		completions := wordDictionary.Autocomplete("Car", 0)
+		assert.EqualValues(t, []string{"cart", "carbon", "car", "card", "care"}, completionWords(completions))
+		assert.EqualValues(t, 3, completions[0].SearchCount)
+
+		//it should return no more than the limit
+		assert.EqualValues(t, []string{"cart", "carbon"}, completionWords(wordDictionary.Autocomplete("car", 2)))
+
+		//it should re-rank a word as its searches are counted
+		for i := 0; i < 4; i++ {
+			wordDictionary.SearchWord("card")
+		}
+		assert.EqualValues(t, []string{"card", "cart", "carbon", "car", "care"}, completionWords(wordDictionary.Autocomplete("car", 0)))
+
+		//it should not count autocompleting as searching
+		wordDictionary.Autocomplete("do", 0)
+		assert.NotContains(t, wordDictionary.Top5SearchKeyWords(), "do")
+		assert.Empty(t, wordDictionary.Autocomplete("x", 0))
+	})
+	t.Run("searched before added test", func(t *testing.T) {
+		wordDictionary := NewWordDictionary("test")
+		wordDictionary.AddWords([]string{"plan", "plane"})
+		wordDictionary.SearchWord("planet")
+		wordDictionary.SearchWord("planet")
+
+		//it should count the searches made before a word was added
+		wordDictionary.AddWords([]string{"planet"})
+		assert.EqualValues(t, []string{"planet", "plan", "plane"}, completionWords(wordDictionary.Autocomplete("pla", 0)))
+	})
+	t.Run("expiry test", func(t *testing.T) {
+		wordDictionary := NewWordDictionary("test")
+		wordDictionary.AddWords([]string{"flag"})
+		wordDictionary.AddWordsWithOptions([]string{"flash"}, AddWordsOptions{ExpiresAt: time.Now().Add(-time.Second)})
+
+		//it should not suggest expired words, before or after they are swept
+		assert.EqualValues(t, []string{"flag"}, completionWords(wordDictionary.Autocomplete("fla", 0)))
+		wordDictionary.RemoveExpiredWords()
+		assert.EqualValues(t, []string{"flag"}, completionWords(wordDictionary.Autocomplete("fla", 0)))
+	})
+	t.Run("blocked test", func(t *testing.T) {
+		wordDictionary := NewWordDictionary("test")
+		words := make([]string, 0, completionTopK+1)
+		for i := 0; i < completionTopK; i++ {
+			words = append(words, fmt.Sprintf("bad%02d", i))
+		}
+		wordDictionary.AddWords(append(words, "badge"))
+		for _, word := range words {
+			wordDictionary.SearchWord(word)
+		}
+		blocklist := NewBlocklist()
+		blocklist.AddTerms(words)
+		wordDictionary.SetBlocklist(blocklist)
+
+		//it should suggest words which were not cached when every cached word is blocked
+		assert.EqualValues(t, []string{"badge"}, completionWords(wordDictionary.Autocomplete("bad", 0)))
+	})
+	t.Run("blend weight test", func(t *testing.T) {
+		wordDictionary := NewWordDictionary("test")
+		wordDictionary.AddWords([]string{"sun", "sunny", "sunday"})
+		wordDictionary.SearchWord("sunday")
+
+		//it should rank by brevity only when the blend weight is 0
+		assert.NoError(t, wordDictionary.SetAutocompleteBlendWeight(0))
+		assert.EqualValues(t, []string{"sun", "sunny", "sunday"}, completionWords(wordDictionary.Autocomplete("sun", 0)))
+
+		//it should rank by popularity when the blend weight is 1
+		assert.NoError(t, wordDictionary.SetAutocompleteBlendWeight(1))
+		assert.EqualValues(t, "sunday", wordDictionary.Autocomplete("sun", 0)[0].Word)
+
+		//it should reject a blend weight outside 0 to 1
+		assert.Error(t, wordDictionary.SetAutocompleteBlendWeight(1.5))
+		assert.Error(t, NewWordSearchService().SetAutocompleteBlendWeight(-0.1))
+		assert.Error(t, NewTenantRegistry(TenantQuota{}, nil).SetAutocompleteBlendWeight(2))
+	})
+	t.Run("service test", func(t *testing.T) {
+		wordSearchService := NewWordSearchService()
+		assert.NoError(t, wordSearchService.SetAutocompleteBlendWeight(0))
+		dictionary, err := wordSearchService.CreateDictionary("later")
+		assert.NoError(t, err)
+		dictionary.AddWords([]string{"tree", "tr"})
+		dictionary.SearchWord("tree")
+
+		//it should apply the blend weight to dictionaries created later
+		assert.EqualValues(t, []string{"tr", "tree"}, completionWords(dictionary.Autocomplete("t", 0)))
+	})
+}
+
+func TestCompletionTrie(t *testing.T) {
+	t.Run("top k test", func(t *testing.T) {
+		trie := newCompletionTrie(1)
+		for i := 0; i < completionTopK+2; i++ {
+			trie.set(fmt.Sprintf("w%02d", i), int64(i+1))
+		}
+
+		//it should cache only the best completions
+		completions := trie.complete("w")
+		assert.Len(t, completions, completionTopK)
+		assert.EqualValues(t, "w11", completions[0].Word)
+		assert.EqualValues(t, "w02", completions[completionTopK-1].Word)
+
+		//it should bring back a word which was not cached when a better one is removed
+		trie.remove("w11")
+		completions = trie.complete("w")
+		assert.EqualValues(t, "w10", completions[0].Word)
+		assert.EqualValues(t, "w01", completions[completionTopK-1].Word)
+
+		//it should bring back a word which was not cached when a better one's score goes down
+		trie.set("w10", 0)
+		completions = trie.complete("w")
+		assert.EqualValues(t, "w09", completions[0].Word)
+		assert.EqualValues(t, "w00", completions[completionTopK-1].Word)
+		assert.NotContains(t, completionWords(completions), "w10")
+
+		//it should ignore removing a word which is not there
+		trie.remove("w99")
+		trie.remove("w")
+		assert.Len(t, trie.complete("w"), completionTopK)
+	})
+	t.Run("filter test", func(t *testing.T) {
+		trie := newCompletionTrie(1)
+		for i := 0; i < completionTopK+2; i++ {
+			trie.set(fmt.Sprintf("w%02d", i), int64(i+1))
+		}
+		notCached := func(word string) bool { return word < "w02" }
+
+		//it should look below the cached completions when the filter leaves too few of them
+		assert.EqualValues(t, []string{"w01", "w00"}, completionWords(trie.completeWhere("w", 5, notCached)))
+		assert.EqualValues(t, []string{"w11", "w10"}, completionWords(trie.completeWhere("w", 2, func(string) bool { return true })))
+		assert.Empty(t, trie.completeWhere("x", 5, notCached))
+	})
+	t.Run("prune test", func(t *testing.T) {
+		trie := newCompletionTrie(1)
+		trie.set("tea", 1)
+		trie.set("team", 1)
+
+		//it should keep the nodes which still lead to a word
+		trie.remove("tea")
+		assert.EqualValues(t, []string{"team"}, completionWords(trie.complete("te")))
+
+		//it should remove the nodes which no longer lead to a word
+		trie.remove("team")
+		assert.Empty(t, trie.root.children)
+		assert.Empty(t, trie.root.top)
+	})
+}
@@ -7,7 +7,22 @@ import (
 
 //Config - defines the config parameters which should be exposed to this microservice
 type Config struct {
-	ListenAddress string `json:"listenAddress"`
+	ListenAddress    string           `json:"listenAddress"`
+	TenantQuota      TenantQuota      `json:"tenantQuota"`
+	ValidationPolicy ValidationPolicy `json:"validationPolicy"`
+	//Tenants - the tenants hosted on this instance. Only the default tenant is hosted, without API keys, when none are given
+	Tenants []TenantConfig `json:"tenants"`
+	//ExpirySweepIntervalSeconds - how often expired words are removed from the dictionaries
+	ExpirySweepIntervalSeconds int `json:"expirySweepIntervalSeconds"`
+	//AuditLogPath - when set, audit events are also appended to this file as JSONL
+	AuditLogPath string `json:"auditLogPath"`
+	//AutocompleteBlendWeight - the share of an autocomplete suggestion's score which comes from how often it was searched, from 0 to 1, the rest coming from its brevity.
+	// DefaultAutocompleteBlendWeight is used when not set
+	AutocompleteBlendWeight *float64 `json:"autocompleteBlendWeight"`
+	//QueryLog - where and how searches are logged. Searches are not logged when QueryLog.Path is not set
+	QueryLog QueryLogConfig `json:"queryLog"`
+	//SynonymsPath - when set, every tenant starts with the synonym groups in this file, one comma separated group per line
+	SynonymsPath string `json:"synonymsPath"`
 }
 
 //ParseConfig - reads the json file at configPath and outputs the Config structure
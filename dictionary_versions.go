@@ -0,0 +1,191 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+//versionHistoryLimit - the number of versions a dictionary keeps. Older versions can no longer be searched or rolled back to
+const versionHistoryLimit = 100
+
+//ErrVersionNotFound - returned when a request names a dictionary version which does not exist or is no longer kept
+var ErrVersionNotFound = errors.New("dictionary version not found")
+
+//wordChange - the state of a single word before and after a mutation. A nil entry means the word was not in the dictionary
+type wordChange struct {
+	word   string
+	before *wordEntry
+	after  *wordEntry
+}
+
+//dictionaryVersion - a mutation of the dictionary and the version number it produced
+type dictionaryVersion struct {
+	version     int64
+	createdAt   time.Time
+	description string
+	changes     []wordChange
+}
+
+//DictionaryVersion - a summary of a dictionary version, as returned by ListVersions
+type DictionaryVersion struct {
+	Version      int64
+	CreatedAt    time.Time
+	Description  string
+	WordsAdded   int
+	WordsUpdated int
+	WordsRemoved int
+}
+
+//Version - returns the current version of the dictionary. A new dictionary is at version 0
+func (wordDictionary *WordDictionary) Version() int64 {
+	wordDictionary.mutex.Lock()
+	defer wordDictionary.mutex.Unlock()
+
+	return wordDictionary.version
+}
+
+//ListVersions - returns the versions which are kept for the dictionary, oldest first
+func (wordDictionary *WordDictionary) ListVersions() (versions []DictionaryVersion) {
+	wordDictionary.mutex.Lock()
+	defer wordDictionary.mutex.Unlock()
+
+	_versions := make([]DictionaryVersion, len(wordDictionary.versions))
+	for i, dictionaryVersion := range wordDictionary.versions {
+		_versions[i].Version = dictionaryVersion.version
+		_versions[i].CreatedAt = dictionaryVersion.createdAt
+		_versions[i].Description = dictionaryVersion.description
+		for _, change := range dictionaryVersion.changes {
+			switch {
+			case change.before == nil:
+				_versions[i].WordsAdded++
+			case change.after == nil:
+				_versions[i].WordsRemoved++
+			default:
+				_versions[i].WordsUpdated++
+			}
+		}
+	}
+	return _versions
+}
+
+//RollbackDictionary - atomically restores the words, and their metadata, of an earlier version. The rollback is itself a mutation, so it produces a new version which is returned
+func (wordDictionary *WordDictionary) RollbackDictionary(version int64) (newVersion int64, err error) {
+	wordDictionary.mutex.Lock()
+	defer wordDictionary.mutex.Unlock()
+
+	wordsAtVersion, err := wordDictionary.wordsAtVersion(version)
+	if err != nil {
+		return 0, err
+	}
+
+	//Work out which words need to be removed, restored or have their metadata restored
+	changes := make([]wordChange, 0)
+	for word, entry := range wordDictionary.dictionaryWords {
+		if wordsAtVersion[word] != entry {
+			changes = append(changes, wordChange{word: word, before: entry, after: wordsAtVersion[word]})
+		}
+	}
+	numberOfNewWords := 0
+	for word, entry := range wordsAtVersion {
+		if wordDictionary.dictionaryWords[word] == nil {
+			changes = append(changes, wordChange{word: word, after: entry})
+			numberOfNewWords++
+		}
+	}
+
+	//Restored words are taken from the tenant word quota, which other dictionaries may have used since
+	if err = wordDictionary.wordQuota.reserve(numberOfNewWords); err != nil {
+		return 0, err
+	}
+
+	return wordDictionary.commitChanges(fmt.Sprintf("rollback to version %d", version), changes), nil
+}
+
+//AuditChanges - describes the words version changed, for the audit log. An expired word is described as not existing.
+// nil is returned if the version is no longer kept
+func (wordDictionary *WordDictionary) AuditChanges(version int64) []AuditWordChange {
+	wordDictionary.mutex.Lock()
+	defer wordDictionary.mutex.Unlock()
+
+	for _, dictionaryVersion := range wordDictionary.versions {
+		if dictionaryVersion.version != version {
+			continue
+		}
+		changes := make([]AuditWordChange, len(dictionaryVersion.changes))
+		for i, change := range dictionaryVersion.changes {
+			changes[i] = AuditWordChange{
+				Word:          change.word,
+				ExistedBefore: change.before != nil && !change.before.expired(dictionaryVersion.createdAt),
+				ExistsAfter:   change.after != nil && !change.after.expired(dictionaryVersion.createdAt),
+			}
+		}
+		return changes
+	}
+	return nil
+}
+
+//commitChanges - applies changes to the dictionary and records them as a new version, which is returned. The caller must hold the mutex
+// and must already have taken any new words from the tenant word quota, removed words are given back to it here
+func (wordDictionary *WordDictionary) commitChanges(description string, changes []wordChange) (version int64) {
+	//The sorted words and tokens are updated once for the whole change, as each insertion or removal would move the rest of the index
+	addedWords := make([]string, 0)
+	removedWords := make([]string, 0)
+	for _, change := range changes {
+		_, exists := wordDictionary.dictionaryWords[change.word]
+		if change.after != nil {
+			wordDictionary.dictionaryWords[change.word] = change.after
+			if !exists {
+				addedWords = append(addedWords, change.word)
+				wordDictionary.stemIndex.add(change.word)
+				wordDictionary.completions.set(change.word, wordDictionary.searchCount(change.word))
+			}
+		} else if exists {
+			delete(wordDictionary.dictionaryWords, change.word)
+			removedWords = append(removedWords, change.word)
+			wordDictionary.stemIndex.remove(change.word)
+			wordDictionary.completions.remove(change.word)
+		}
+	}
+	wordDictionary.sortedWords.removeAll(removedWords)
+	wordDictionary.sortedWords.insertAll(addedWords)
+	wordDictionary.tokenIndex.removeAll(removedWords)
+	wordDictionary.tokenIndex.addAll(addedWords)
+	wordDictionary.wordQuota.release(len(removedWords))
+
+	wordDictionary.version++
+	wordDictionary.versions = append(wordDictionary.versions, &dictionaryVersion{
+		version:     wordDictionary.version,
+		createdAt:   wordDictionary.now(),
+		description: description,
+		changes:     changes,
+	})
+	if len(wordDictionary.versions) > versionHistoryLimit {
+		wordDictionary.versions = wordDictionary.versions[len(wordDictionary.versions)-versionHistoryLimit:]
+	}
+	return wordDictionary.version
+}
+
+//wordsAtVersion - reconstructs the dictionary words as they were at version by undoing every later mutation. The caller must hold the mutex
+func (wordDictionary *WordDictionary) wordsAtVersion(version int64) (words map[string]*wordEntry, err error) {
+	oldestVersion := wordDictionary.version - int64(len(wordDictionary.versions))
+	if version < oldestVersion || version > wordDictionary.version {
+		return nil, errors.Wrap(ErrVersionNotFound, fmt.Sprintf("version %d", version))
+	}
+
+	_words := make(map[string]*wordEntry, len(wordDictionary.dictionaryWords))
+	for word, entry := range wordDictionary.dictionaryWords {
+		_words[word] = entry
+	}
+	for i := len(wordDictionary.versions) - 1; i >= 0 && wordDictionary.versions[i].version > version; i-- {
+		for _, change := range wordDictionary.versions[i].changes {
+			if change.before != nil {
+				_words[change.word] = change.before
+			} else {
+				delete(_words, change.word)
+			}
+		}
+	}
+	return _words, nil
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//searchWords - runs a search and returns just the matched words
+func searchWords(t *testing.T, wordDictionary *WordDictionary, keyWord string, options SearchOptions) []string {
+	searchResults, err := wordDictionary.Search(keyWord, options)
+	if err != nil {
+		t.Errorf("Search() returned an error %+v", err)
+		return nil
+	}
+	words := make([]string, len(searchResults.Matches))
+	for i := range searchResults.Matches {
+		words[i] = searchResults.Matches[i].Word
+	}
+	return words
+}
+
+func TestWordDictionary_Versions(t *testing.T) {
+	t.Run("every mutation produces a version", func(t *testing.T) {
+		wordDictionary := NewWordDictionary("test")
+
+		//it should start at version 0
+		assert.EqualValues(t, 0, wordDictionary.Version())
+		assert.EqualValues(t, []DictionaryVersion{}, wordDictionary.ListVersions())
+
+		//it should produce a new version for AddWords and for a bulk ingestion
+		wordDictionary.AddWords([]string{"apple", "banana"})
+		wordIngester := NewWordIngester(wordDictionary, 0)
+		wordIngester.Add([]string{"cherry"})
+		wordIngester.Close()
+		assert.EqualValues(t, 2, wordDictionary.Version())
+
+		versions := wordDictionary.ListVersions()
+		if assert.Len(t, versions, 2) {
+			assert.EqualValues(t, 1, versions[0].Version)
+			assert.EqualValues(t, 2, versions[0].WordsAdded)
+			assert.EqualValues(t, 2, versions[1].Version)
+			assert.EqualValues(t, 1, versions[1].WordsAdded)
+		}
+
+		//it should not produce a version when AddWords fails
+		assert.Error(t, wordDictionary.AddWords([]string{"apple"}))
+		assert.EqualValues(t, 2, wordDictionary.Version())
+	})
+	t.Run("point in time reads", func(t *testing.T) {
+		wordDictionary := NewWordDictionary("test")
+		wordDictionary.AddWords([]string{"apple"})
+		wordDictionary.AddWords([]string{"apricot"})
+
+		//it should search the current version by default
+		assert.EqualValues(t, []string{"apple", "apricot"}, searchWords(t, wordDictionary, "ap", SearchOptions{}))
+
+		//it should search an earlier version when pinned
+		assert.EqualValues(t, []string{"apple"}, searchWords(t, wordDictionary, "ap", SearchOptions{Version: 1}))
+		searchResults, _ := wordDictionary.Search("ap", SearchOptions{Version: 1})
+		assert.EqualValues(t, 1, searchResults.Version)
+
+		//it should refuse to search a version which does not exist
+		_, err := wordDictionary.Search("ap", SearchOptions{Version: 3})
+		assert.Error(t, err)
+	})
+	t.Run("rollback", func(t *testing.T) {
+		wordDictionary := NewWordDictionary("test")
+		wordDictionary.AddWords([]string{"apple"})
+		wordDictionary.AddWords([]string{"apricot", "avocado"})
+
+		//it should restore the words of the earlier version as a new version
+		newVersion, err := wordDictionary.RollbackDictionary(1)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 3, newVersion)
+		assert.EqualValues(t, []string{"apple"}, wordDictionary.SearchWord("a"))
+		assert.EqualValues(t, 2, wordDictionary.ListVersions()[2].WordsRemoved)
+
+		//it should allow the rollback itself to be undone
+		_, err = wordDictionary.RollbackDictionary(2)
+		assert.NoError(t, err)
+		assert.EqualValues(t, []string{"apple", "apricot", "avocado"}, wordDictionary.SearchWord("a"))
+
+		//it should refuse to roll back to a version which does not exist
+		_, err = wordDictionary.RollbackDictionary(10)
+		assert.Error(t, err)
+	})
+	t.Run("audit changes", func(t *testing.T) {
+		now := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+		wordDictionary := NewWordDictionary("test")
+		wordDictionary.now = func() time.Time { return now }
+		version, _ := wordDictionary.AddWordsWithOptions([]string{"flash"}, AddWordsOptions{TTL: time.Minute})
+
+		//it should describe the words the version changed
+		assert.EqualValues(t, []AuditWordChange{{Word: "flash", ExistsAfter: true}}, wordDictionary.AuditChanges(version))
+
+		//it should describe an expired word as not existing
+		now = now.Add(time.Hour)
+		version, _ = wordDictionary.AddWordsWithOptions([]string{"flash", "Flash"}, AddWordsOptions{})
+		assert.EqualValues(t, []AuditWordChange{{Word: "flash", ExistsAfter: true}}, wordDictionary.AuditChanges(version))
+		version, _ = wordDictionary.RollbackDictionary(version - 1)
+		assert.EqualValues(t, []AuditWordChange{{Word: "flash", ExistedBefore: true}}, wordDictionary.AuditChanges(version))
+
+		//it should describe no changes for a version which is not kept
+		assert.Nil(t, wordDictionary.AuditChanges(version+1))
+	})
+}
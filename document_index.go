@@ -0,0 +1,185 @@
+package main
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+//ErrDocumentNotFound - returned when a request names a document which has not been indexed
+var ErrDocumentNotFound = errors.New("document not found")
+
+//defaultDocumentSearchLimit - the number of documents returned when the caller does not specify a limit
+const defaultDocumentSearchLimit = 10
+
+//bm25K1 and bm25B - the BM25 parameters. k1 controls how quickly repeated terms stop adding to the score, b how much long documents are penalized
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+//DocumentMatch - a document matched by SearchDocuments, along with its BM25 score
+type DocumentMatch struct {
+	ID    string
+	Score float64
+}
+
+//indexedDocument - a document held in the index
+type indexedDocument struct {
+	text string
+	//length - the number of terms in the document
+	length int
+	//termFrequencies - the number of times each term appears in the document
+	termFrequencies map[string]int
+}
+
+//DocumentIndex - a full-text index of documents, searched with BM25 ranking.
+// Documents are tokenized and normalized the same way as dictionary words, so documents and dictionaries share a vocabulary
+type DocumentIndex struct {
+	mutex     sync.RWMutex
+	documents map[string]*indexedDocument
+	//postings - for each term, the ids of the documents containing it
+	postings    map[string]map[string]bool
+	totalLength int
+	//wordQuota - the tenant word quota the terms of the documents are taken from
+	wordQuota *wordQuota
+}
+
+//NewDocumentIndex - creates a new, empty DocumentIndex
+func NewDocumentIndex() *DocumentIndex {
+	newDocumentIndex := new(DocumentIndex)
+	newDocumentIndex.documents = make(map[string]*indexedDocument)
+	newDocumentIndex.postings = make(map[string]map[string]bool)
+	return newDocumentIndex
+}
+
+//IndexDocument - adds the document with the given id to the index, replacing any document already indexed with that id
+func (documentIndex *DocumentIndex) IndexDocument(id string, text string) error {
+	if strings.TrimSpace(id) == "" {
+		return errors.New("a document needs an id")
+	}
+	document := &indexedDocument{text: text, termFrequencies: make(map[string]int)}
+	for _, token := range tokenizeText(text) {
+		document.termFrequencies[token.word]++
+		document.length++
+	}
+
+	documentIndex.mutex.Lock()
+	defer documentIndex.mutex.Unlock()
+
+	//Would the document take the tenant over its word limit? A replaced document's terms are given back
+	replacedLength := 0
+	if replaced := documentIndex.documents[id]; replaced != nil {
+		replacedLength = replaced.length
+	}
+	if err := documentIndex.wordQuota.reserve(document.length - replacedLength); err != nil {
+		return err
+	}
+	documentIndex.wordQuota.release(replacedLength - document.length)
+
+	documentIndex.removeDocument(id)
+	documentIndex.documents[id] = document
+	documentIndex.totalLength += document.length
+	for term := range document.termFrequencies {
+		if documentIndex.postings[term] == nil {
+			documentIndex.postings[term] = make(map[string]bool)
+		}
+		documentIndex.postings[term][id] = true
+	}
+	return nil
+}
+
+//DeleteDocument - removes the document with the given id from the index
+func (documentIndex *DocumentIndex) DeleteDocument(id string) error {
+	documentIndex.mutex.Lock()
+	defer documentIndex.mutex.Unlock()
+
+	document := documentIndex.documents[id]
+	if !documentIndex.removeDocument(id) {
+		return errors.Wrap(ErrDocumentNotFound, id)
+	}
+	documentIndex.wordQuota.release(document.length)
+	return nil
+}
+
+//removeDocument - removes the document with the given id, reporting whether it was indexed. The caller must hold the mutex
+func (documentIndex *DocumentIndex) removeDocument(id string) bool {
+	document := documentIndex.documents[id]
+	if document == nil {
+		return false
+	}
+	delete(documentIndex.documents, id)
+	documentIndex.totalLength -= document.length
+	for term := range document.termFrequencies {
+		delete(documentIndex.postings[term], id)
+		if len(documentIndex.postings[term]) == 0 {
+			delete(documentIndex.postings, term)
+		}
+	}
+	return true
+}
+
+//Document - returns the text of the document with the given id
+func (documentIndex *DocumentIndex) Document(id string) (text string, err error) {
+	documentIndex.mutex.RLock()
+	defer documentIndex.mutex.RUnlock()
+
+	document := documentIndex.documents[id]
+	if document == nil {
+		return "", errors.Wrap(ErrDocumentNotFound, id)
+	}
+	return document.text, nil
+}
+
+//SearchDocuments - returns up to limit documents containing any of the query's terms, highest BM25 score first and then by id
+func (documentIndex *DocumentIndex) SearchDocuments(query string, limit int) []DocumentMatch {
+	if limit <= 0 {
+		limit = defaultDocumentSearchLimit
+	}
+
+	documentIndex.mutex.RLock()
+	defer documentIndex.mutex.RUnlock()
+
+	matches := make([]DocumentMatch, 0)
+	if len(documentIndex.documents) == 0 {
+		return matches
+	}
+	numberOfDocuments := float64(len(documentIndex.documents))
+	averageLength := float64(documentIndex.totalLength) / numberOfDocuments
+
+	scores := make(map[string]float64)
+	seenTerms := make(map[string]bool)
+	for _, token := range tokenizeText(query) {
+		if seenTerms[token.word] {
+			continue
+		}
+		seenTerms[token.word] = true
+
+		postings := documentIndex.postings[token.word]
+		documentFrequency := float64(len(postings))
+		idf := math.Log(1 + (numberOfDocuments-documentFrequency+0.5)/(documentFrequency+0.5))
+		for id := range postings {
+			document := documentIndex.documents[id]
+			termFrequency := float64(document.termFrequencies[token.word])
+			lengthNorm := 1 - bm25B + bm25B*float64(document.length)/averageLength
+			scores[id] += idf * termFrequency * (bm25K1 + 1) / (termFrequency + bm25K1*lengthNorm)
+		}
+	}
+
+	for id, score := range scores {
+		matches = append(matches, DocumentMatch{ID: id, Score: score})
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+		return matches[i].ID < matches[j].ID
+	})
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
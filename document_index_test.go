@@ -0,0 +1,64 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDocumentIndex(t *testing.T) {
+	t.Run("bm25 test", func(t *testing.T) {
+		documentIndex := NewDocumentIndex()
+		documentIndex.IndexDocument("cats", "The cat sat on the mat. The cat was happy.")
+		documentIndex.IndexDocument("dogs", "The dog chased the cat around the garden for a very long time.")
+		documentIndex.IndexDocument("birds", "Birds sing in the morning.")
+
+		//it should rank documents with more occurrences of the rarer terms higher
+		matches := documentIndex.SearchDocuments("CAT", 0)
+		assert.EqualValues(t, []string{"cats", "dogs"}, documentMatchIDs(matches))
+		assert.True(t, matches[0].Score > matches[1].Score)
+
+		//it should add up the scores of each query term
+		matches = documentIndex.SearchDocuments("dog cat", 0)
+		assert.EqualValues(t, []string{"dogs", "cats"}, documentMatchIDs(matches))
+
+		//it should give terms found in every document less weight than rare terms
+		matches = documentIndex.SearchDocuments("the morning", 0)
+		assert.EqualValues(t, "birds", matches[0].ID)
+
+		//it should limit the number of documents returned
+		assert.Len(t, documentIndex.SearchDocuments("the", 2), 2)
+
+		//it should return nothing for unknown terms
+		assert.EqualValues(t, []DocumentMatch{}, documentIndex.SearchDocuments("zebra", 0))
+	})
+	t.Run("update and delete test", func(t *testing.T) {
+		documentIndex := NewDocumentIndex()
+		documentIndex.IndexDocument("a", "apples and pears")
+
+		//it should replace a document indexed again with the same id
+		documentIndex.IndexDocument("a", "plums")
+		assert.EqualValues(t, []DocumentMatch{}, documentIndex.SearchDocuments("apples", 0))
+		assert.EqualValues(t, []string{"a"}, documentMatchIDs(documentIndex.SearchDocuments("plums", 0)))
+		text, _ := documentIndex.Document("a")
+		assert.EqualValues(t, "plums", text)
+
+		//it should delete documents
+		assert.NoError(t, documentIndex.DeleteDocument("a"))
+		assert.EqualValues(t, []DocumentMatch{}, documentIndex.SearchDocuments("plums", 0))
+		assert.True(t, errors.Cause(documentIndex.DeleteDocument("a")) == ErrDocumentNotFound)
+
+		//it should refuse documents without an id
+		assert.Error(t, documentIndex.IndexDocument(" ", "text"))
+	})
+}
+
+//documentMatchIDs - returns the ids of matches
+func documentMatchIDs(matches []DocumentMatch) []string {
+	ids := make([]string, len(matches))
+	for i := range matches {
+		ids[i] = matches[i].ID
+	}
+	return ids
+}
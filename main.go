@@ -1,12 +1,15 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
+	"os"
+	"time"
 
 	"flag"
 
-	wordsearchsystemgrpc "github.com/chrisjpalmer/word_search_system_grpc"
+	wordsearchsystemgrpc "github.com/chrisjpalmer/word_search_system/word_search_system_grpc"
 	"google.golang.org/grpc"
 )
 
@@ -18,7 +21,54 @@ func main() {
 	)
 	//Get config
 	configPath := flag.String("config", "config.json", "/path/to/config.json")
+	replayPath := flag.String("replay", "", "/path/to/query_log.jsonl to replay against the server at -target, instead of starting a server")
+	exportDictionary := flag.String("export", "", "the name of a dictionary to export from the server at -target into -out, instead of starting a server")
+	exportFormat := flag.String("format", "text", "the format of the export, text, jsonl or csv")
+	exportPath := flag.String("out", "", "/path/to/export file, which is written to standard output when not set")
+	target := flag.String("target", "localhost:50051", "the address of the server to replay the query log against or export from")
+	tenantID := flag.String("tenant", "", "the tenant to export from. Defaults to the tenant the API key belongs to, or the default tenant without an API key")
+	apiKey := flag.String("api-key", os.Getenv("WORD_SEARCH_API_KEY"), "the API key to authenticate with the server at -target when exporting or replaying, defaults to $WORD_SEARCH_API_KEY")
 	flag.Parse()
+
+	//Export a dictionary from a running server
+	if *exportDictionary != "" {
+		format, err := ParseExportFormat(*exportFormat)
+		if err != nil {
+			log.Fatalf("invalid export format: %v", err)
+		}
+		connection, err := grpc.Dial(*target, grpc.WithInsecure())
+		if err != nil {
+			log.Fatalf("failed to connect: %v", err)
+		}
+		defer connection.Close()
+		client := wordsearchsystemgrpc.NewWordSearchSystemClient(connection)
+		ctx := WithClientCredentials(context.Background(), *tenantID, *apiKey)
+		if *exportPath == "" {
+			err = DownloadWords(ctx, client, *exportDictionary, format, os.Stdout)
+		} else {
+			err = DownloadWordsFile(ctx, client, *exportDictionary, format, *exportPath)
+		}
+		if err != nil {
+			log.Fatalf("failed to export dictionary: %v", err)
+		}
+		return
+	}
+
+	//Replay a query log against a running server
+	if *replayPath != "" {
+		connection, err := grpc.Dial(*target, grpc.WithInsecure())
+		if err != nil {
+			log.Fatalf("failed to connect: %v", err)
+		}
+		defer connection.Close()
+		summary, err := ReplayQueryLogFile(context.Background(), *replayPath, wordsearchsystemgrpc.NewWordSearchSystemClient(connection), *apiKey)
+		log.Printf("replayed %d searches, %d failed, %d returned a different number of matches", summary.Queries, summary.Failed, summary.MatchCountChanged)
+		if err != nil {
+			log.Fatalf("failed to replay query log: %v", err)
+		}
+		return
+	}
+
 	config, err = ParseConfig(*configPath)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
@@ -27,8 +77,68 @@ func main() {
 	//Announce start
 	log.Println("WordSearchSystem has started")
 
-	//Create the word search service
-	wordSearchService := NewWordSearchService()
+	//Compile the policy words must satisfy to be added
+	wordValidator, err := NewWordValidator(config.ValidationPolicy)
+	if err != nil {
+		log.Fatalf("invalid validation policy: %v", err)
+	}
+
+	//Create the tenant registry, which holds a word search service per tenant
+	tenantRegistry := NewTenantRegistry(config.TenantQuota, wordValidator)
+
+	//Rank autocomplete suggestions with the configured blend of popularity and brevity
+	if config.AutocompleteBlendWeight != nil {
+		if err = tenantRegistry.SetAutocompleteBlendWeight(*config.AutocompleteBlendWeight); err != nil {
+			log.Fatalf("invalid autocomplete blend weight: %v", err)
+		}
+	}
+
+	//Start each tenant with the synonym groups from the synonyms file, if there is one
+	if config.SynonymsPath != "" {
+		synonymGroups, err := LoadSynonymFile(config.SynonymsPath)
+		if err != nil {
+			log.Fatalf("failed to load synonyms: %v", err)
+		}
+		if err = tenantRegistry.SetSynonymGroups(synonymGroups); err != nil {
+			log.Fatalf("invalid synonyms: %v", err)
+		}
+	}
+
+	//Host the configured tenants, or a default tenant open to every client when none are configured
+	tenantConfigs := config.Tenants
+	if len(tenantConfigs) == 0 {
+		tenantConfigs = []TenantConfig{{ID: DefaultTenantID}}
+	}
+	for _, tenantConfig := range tenantConfigs {
+		if _, err = tenantRegistry.AddTenant(tenantConfig); err != nil {
+			log.Fatalf("invalid tenant: %v", err)
+		}
+	}
+
+	//Remove expired words in the background
+	stopExpirySweeper := StartExpirySweeper(tenantRegistry, time.Duration(config.ExpirySweepIntervalSeconds)*time.Second)
+	defer stopExpirySweeper()
+
+	//Create the audit log, appending to the audit log file if there is one
+	auditLog := NewAuditLog(nil)
+	if config.AuditLogPath != "" {
+		var auditLogFile *os.File
+		auditLog, auditLogFile, err = OpenAuditLogFile(config.AuditLogPath)
+		if err != nil {
+			log.Fatalf("failed to open audit log: %v", err)
+		}
+		defer auditLogFile.Close()
+	}
+
+	//Open the query log, if searches are logged
+	var queryLog *QueryLog
+	if config.QueryLog.Path != "" {
+		queryLog, err = NewQueryLog(config.QueryLog)
+		if err != nil {
+			log.Fatalf("failed to open query log: %v", err)
+		}
+		defer queryLog.Close()
+	}
 
 	//Create the listener for the specific address
 	listener, err = net.Listen("tcp", config.ListenAddress)
@@ -40,7 +150,7 @@ func main() {
 	grpcServer := grpc.NewServer()
 
 	//Create the WordSearchSystemServer
-	wordSearchSystemServer := NewWordSearchSystemServer(wordSearchService)
+	wordSearchSystemServer := NewWordSearchSystemServer(tenantRegistry, auditLog, queryLog)
 
 	//Connect the Server, with the proto definitions with the instance of the grpcServer
 	wordsearchsystemgrpc.RegisterWordSearchSystemServer(grpcServer, wordSearchSystemServer)
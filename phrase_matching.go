@@ -0,0 +1,96 @@
+package main
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+//MatchMode - how a keyword is matched against the dictionary words
+type MatchMode int
+
+const (
+	//MatchSubstring - words containing the keyword anywhere, e.g. "rea" finds "ice cream". The default
+	MatchSubstring MatchMode = iota
+	//MatchPhrasePrefix - words whose tokens start with the keyword's tokens, the last keyword token being a prefix, e.g. "ice cr" finds "ice cream"
+	MatchPhrasePrefix
+	//MatchTokenPrefix - as MatchPhrasePrefix, but the keyword's tokens may start at any token of the word, e.g. "cre" finds "ice cream" through its second token
+	MatchTokenPrefix
+)
+
+//tokenIndexSeparator - separates the token from the word in the entries of a tokenIndex. Words cannot contain control characters
+const tokenIndexSeparator = "\x00"
+
+//tokenIndex - the tokens of every dictionary word, held as sorted "token\x00word" entries, so that words can be found by a prefix of any of their tokens
+type tokenIndex struct {
+	entries sortedWordIndex
+}
+
+//addAll - adds the tokens of words to the index. The entries of all of the words are merged into the index at once, see sortedWordIndex.insertAll
+func (index *tokenIndex) addAll(words []string) {
+	index.entries.insertAll(tokenIndexEntries(words))
+}
+
+//removeAll - removes the tokens of words from the index
+func (index *tokenIndex) removeAll(words []string) {
+	index.entries.removeAll(tokenIndexEntries(words))
+}
+
+//tokenIndexEntries - returns the "token\x00word" entry of each token of words
+func tokenIndexEntries(words []string) []string {
+	entries := make([]string, 0, len(words))
+	for _, word := range words {
+		for _, token := range tokenizeText(word) {
+			entries = append(entries, token.word+tokenIndexSeparator+word)
+		}
+	}
+	return entries
+}
+
+//entriesWithTokenPrefixes - returns the entries of dictionaryWords which have a token starting with one of prefixes
+func (index *tokenIndex) entriesWithTokenPrefixes(prefixes []string, dictionaryWords map[string]*wordEntry) map[string]*wordEntry {
+	entries := make(map[string]*wordEntry)
+	for _, prefix := range prefixes {
+		for i := sort.SearchStrings(index.entries, prefix); i < len(index.entries) && strings.HasPrefix(index.entries[i], prefix); i++ {
+			word := index.entries[i][strings.Index(index.entries[i], tokenIndexSeparator)+1:]
+			if entry := dictionaryWords[word]; entry != nil {
+				entries[word] = entry
+			}
+		}
+	}
+	return entries
+}
+
+//matchTokenRun - returns the index of the first of wordTokens at which keyWordTokens match: each keyword token equal to a word token, except the last which only has to be a prefix.
+// The match must start at the first word token unless anyStart is set
+func matchTokenRun(wordTokens []textToken, keyWordTokens []textToken, anyStart bool) (start int, ok bool) {
+	if len(keyWordTokens) == 0 {
+		return 0, true
+	}
+	last := len(keyWordTokens) - 1
+	for start = 0; start+last < len(wordTokens); start++ {
+		ok = strings.HasPrefix(wordTokens[start+last].word, keyWordTokens[last].word)
+		for i := 0; ok && i < last; i++ {
+			ok = wordTokens[start+i].word == keyWordTokens[i].word
+		}
+		if ok {
+			return start, true
+		}
+		if !anyStart {
+			break
+		}
+	}
+	return 0, false
+}
+
+//tokenHighlightSpans - returns the span of word matched by keyWordTokens, from the start of the first matched token to the end of the last keyword token
+func tokenHighlightSpans(word string, keyWordTokens []textToken, anyStart bool) []HighlightSpan {
+	wordTokens := tokenizeText(word)
+	start, ok := matchTokenRun(wordTokens, keyWordTokens, anyStart)
+	if !ok || len(keyWordTokens) == 0 {
+		return []HighlightSpan{}
+	}
+	last := keyWordTokens[len(keyWordTokens)-1]
+	lastWordToken := wordTokens[start+len(keyWordTokens)-1]
+	return []HighlightSpan{{Start: wordTokens[start].runeStart, End: lastWordToken.runeStart + utf8.RuneCountInString(last.word)}}
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPhraseMatching(t *testing.T) {
+	wordDictionary := NewWordDictionary("test")
+	wordDictionary.AddWords([]string{"ice cream", "ice cream sandwich", "iceberg", "cream", "scream", "creamery", "sour  cream"})
+
+	t.Run("match mode test", func(t *testing.T) {
+		tests := []struct {
+			keyWord   string
+			matchMode MatchMode
+			matches   []string
+		}{
+			//it should find phrases through any of their tokens
+			{"cre", MatchTokenPrefix, []string{"cream", "creamery", "ice cream", "ice cream sandwich", "sour  cream"}},
+			//it should match several keyword tokens in a row, whatever the spacing between them
+			{"sour cr", MatchTokenPrefix, []string{"sour  cream"}},
+			{"cream sand", MatchTokenPrefix, []string{"ice cream sandwich"}},
+			//it should only match from the first token for phrase prefixes
+			{"ice cr", MatchPhrasePrefix, []string{"ice cream", "ice cream sandwich"}},
+			{"cre", MatchPhrasePrefix, []string{"cream", "creamery"}},
+			{"ice", MatchPhrasePrefix, []string{"ice cream", "ice cream sandwich", "iceberg"}},
+			//it should keep matching raw substrings by default
+			{"cre", MatchSubstring, []string{"cream", "creamery", "ice cream", "ice cream sandwich", "scream", "sour  cream"}},
+		}
+		for _, test := range tests {
+			results, err := wordDictionary.Search(test.keyWord, SearchOptions{MatchMode: test.matchMode})
+			assert.NoError(t, err)
+			assert.EqualValues(t, test.matches, matchWords(results.Matches), test.keyWord)
+		}
+	})
+	t.Run("ranking test", func(t *testing.T) {
+		//it should rank prefix matches, then token prefix matches, then infix matches
+		results, _ := wordDictionary.Search("cre", SearchOptions{RankByRelevance: true})
+		assert.EqualValues(t, []string{"cream", "creamery", "ice cream", "sour  cream", "ice cream sandwich", "scream"}, matchWords(results.Matches))
+
+		//it should highlight the matched tokens
+		results, _ = wordDictionary.Search("cream sa", SearchOptions{MatchMode: MatchTokenPrefix, IncludeHighlights: true})
+		assert.EqualValues(t, []HighlightSpan{{4, 12}}, results.Matches[0].Highlights)
+	})
+	t.Run("token index test", func(t *testing.T) {
+		wordDictionary := NewWordDictionary("test")
+		wordDictionary.AddWords([]string{"ice cream", "whipped cream"})
+
+		//it should stop finding removed phrases through their tokens
+		wordDictionary.UpsertWords([]WordEntry{{Word: "ice cream", ExpiresAt: time.Now().Add(-time.Minute)}})
+		wordDictionary.RemoveExpiredWords()
+		results, _ := wordDictionary.Search("cre", SearchOptions{MatchMode: MatchTokenPrefix})
+		assert.EqualValues(t, []string{"whipped cream"}, matchWords(results.Matches))
+
+		//it should find phrases added together through each of their tokens, including tokens they share
+		wordDictionary.AddWords([]string{"sour cream", "cream cheese", "sour cherry"})
+		results, _ = wordDictionary.Search("cre", SearchOptions{MatchMode: MatchTokenPrefix})
+		assert.EqualValues(t, []string{"cream cheese", "sour cream", "whipped cream"}, matchWords(results.Matches))
+		results, _ = wordDictionary.Search("sour", SearchOptions{MatchMode: MatchTokenPrefix})
+		assert.EqualValues(t, []string{"sour cherry", "sour cream"}, matchWords(results.Matches))
+		assert.True(t, sortedWordIndexIsSorted(wordDictionary.tokenIndex.entries))
+	})
+}
@@ -0,0 +1,383 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	wordsearchsystemgrpc "github.com/chrisjpalmer/word_search_system/word_search_system_grpc"
+	"github.com/pkg/errors"
+)
+
+//queryLogRotationTimeFormat - the format of the time a rotated query log file was rotated at, which is appended to its name. It sorts in time order
+const queryLogRotationTimeFormat = "20060102T150405.000000000"
+
+//queryLogBufferSize - the number of entries which can wait to be written before further entries are dropped
+const queryLogBufferSize = 1024
+
+//queryLogRetentionInterval - how often rotated files are checked against the retention period, besides when the file is rotated
+var queryLogRetentionInterval = time.Hour
+
+//ErrQueryLogFull - returned when an entry is dropped because the entries waiting to be written have filled the buffer
+var ErrQueryLogFull = errors.New("query log buffer is full")
+
+//ErrQueryLogClosed - returned when an entry is recorded after the query log was closed
+var ErrQueryLogClosed = errors.New("query log is closed")
+
+//QueryLogConfig - the settings of the query log
+type QueryLogConfig struct {
+	//Path - when set, searches are logged to this file as JSONL
+	Path string `json:"path"`
+	//SampleRate - the fraction of searches which are logged, from 0 to 1. No searches are logged when 0, and every search is logged when not set
+	SampleRate *float64 `json:"sampleRate"`
+	//MaxFileMegabytes - the size the file may grow to before it is rotated. The file is not rotated when 0
+	MaxFileMegabytes int `json:"maxFileMegabytes"`
+	//MaxBackups - the number of rotated files which are kept. All of them are kept when 0
+	MaxBackups int `json:"maxBackups"`
+	//RetentionDays - how long rotated files are kept for. They are kept regardless of age when 0
+	RetentionDays int `json:"retentionDays"`
+}
+
+//QueryLogEntry - a record of a search
+type QueryLogEntry struct {
+	Time        time.Time `json:"time"`
+	Caller      string    `json:"caller,omitempty"`
+	PeerAddress string    `json:"peerAddress,omitempty"`
+	TenantID    string    `json:"tenantId"`
+	Dictionary  string    `json:"dictionary"`
+	KeyWord     string    `json:"keyWord"`
+	//Request - the search request as it was made, and Metadata the request metadata which changed how the search was made, such as session-id,
+	// so that the search can be replayed
+	Request  *wordsearchsystemgrpc.SearchWordRequest `json:"request,omitempty"`
+	Metadata map[string]string                       `json:"metadata,omitempty"`
+	//MatchCount - the number of words the search matched, including those on later pages than the one returned
+	MatchCount int `json:"matchCount"`
+	//LatencyMicroseconds - how long the search took to answer
+	LatencyMicroseconds int64 `json:"latencyMicroseconds"`
+}
+
+//queryLogWrite - an entry waiting to be written to the query log, or a request to be told once the entries before it are written
+type queryLogWrite struct {
+	entry   *QueryLogEntry
+	flushed chan struct{}
+}
+
+//QueryLog - a log of searches written to a file as JSONL. Entries are written in the background so that searches do not wait on the file.
+// The file is rotated once it reaches its maximum size, and rotated files are removed once there are too many or they are too old
+type QueryLog struct {
+	//mutex - guards the file
+	mutex  sync.Mutex
+	config QueryLogConfig
+	file   *os.File
+	size   int64
+	//writesMutex - guards sending to writes, which is closed once the query log is closed
+	writesMutex sync.RWMutex
+	writes      chan queryLogWrite
+	closed      bool
+	//writerDone - closed once every entry has been written after the query log is closed
+	writerDone chan struct{}
+	//writeErr - the last error the background writer ran into, which Close returns
+	writeErr error
+	now      func() time.Time
+	//sample - returns a random number in [0, 1) to decide whether a search is logged
+	sample func() float64
+}
+
+//NewQueryLog - opens the query log at config.Path, appending to it if it already exists
+func NewQueryLog(config QueryLogConfig) (*QueryLog, error) {
+	if config.Path == "" {
+		return nil, errors.New("the query log needs a path")
+	}
+	if config.SampleRate != nil && !(*config.SampleRate >= 0 && *config.SampleRate <= 1) {
+		return nil, errors.New(fmt.Sprintf("query log sample rate must be between 0 and 1, got %v", *config.SampleRate))
+	}
+	if config.MaxFileMegabytes < 0 || config.MaxBackups < 0 || config.RetentionDays < 0 {
+		return nil, errors.New("query log sizes and retention cannot be negative")
+	}
+
+	newQueryLog := new(QueryLog)
+	newQueryLog.config = config
+	newQueryLog.now = time.Now
+	newQueryLog.sample = rand.Float64
+	if err := newQueryLog.open(); err != nil {
+		return nil, err
+	}
+	if err := newQueryLog.removeOldFiles(); err != nil {
+		newQueryLog.file.Close()
+		return nil, err
+	}
+	newQueryLog.writes = make(chan queryLogWrite, queryLogBufferSize)
+	newQueryLog.writerDone = make(chan struct{})
+	go newQueryLog.writeEntries(time.NewTicker(queryLogRetentionInterval))
+	return newQueryLog, nil
+}
+
+//Record - logs entry, assigning its Time, unless it is left out by sampling. The entry is written in the background,
+// and dropped with ErrQueryLogFull if too many entries are waiting to be written. A nil QueryLog logs nothing
+func (queryLog *QueryLog) Record(entry QueryLogEntry) error {
+	if queryLog == nil {
+		return nil
+	}
+	if sampleRate := queryLog.config.SampleRate; sampleRate != nil && queryLog.sample() >= *sampleRate {
+		return nil
+	}
+	entry.Time = queryLog.now()
+
+	queryLog.writesMutex.RLock()
+	defer queryLog.writesMutex.RUnlock()
+
+	if queryLog.closed {
+		return ErrQueryLogClosed
+	}
+	select {
+	case queryLog.writes <- queryLogWrite{entry: &entry}:
+		return nil
+	default:
+		return ErrQueryLogFull
+	}
+}
+
+//flush - waits until the entries recorded so far have been written
+func (queryLog *QueryLog) flush() {
+	queryLog.writesMutex.RLock()
+	if queryLog.closed {
+		queryLog.writesMutex.RUnlock()
+		return
+	}
+	flushed := make(chan struct{})
+	queryLog.writes <- queryLogWrite{flushed: flushed}
+	queryLog.writesMutex.RUnlock()
+	<-flushed
+}
+
+//Close - writes the entries waiting to be written and closes the query log's file
+func (queryLog *QueryLog) Close() error {
+	queryLog.writesMutex.Lock()
+	if !queryLog.closed {
+		queryLog.closed = true
+		close(queryLog.writes)
+	}
+	queryLog.writesMutex.Unlock()
+	<-queryLog.writerDone
+
+	queryLog.mutex.Lock()
+	defer queryLog.mutex.Unlock()
+
+	if err := queryLog.file.Close(); err != nil {
+		return err
+	}
+	return queryLog.writeErr
+}
+
+//writeEntries - writes the entries sent to the query log until it is closed, and removes old rotated files on each tick of retentionTicker
+func (queryLog *QueryLog) writeEntries(retentionTicker *time.Ticker) {
+	defer close(queryLog.writerDone)
+	defer retentionTicker.Stop()
+
+	for {
+		select {
+		case write, ok := <-queryLog.writes:
+			if !ok {
+				return
+			}
+			if write.flushed != nil {
+				close(write.flushed)
+				continue
+			}
+			if err := queryLog.write(write.entry); err != nil {
+				log.Printf("failed to write query log entry: %v", err)
+			}
+		case <-retentionTicker.C:
+			queryLog.mutex.Lock()
+			err := queryLog.removeOldFiles()
+			queryLog.mutex.Unlock()
+			if err != nil {
+				log.Printf("failed to apply query log retention: %v", err)
+			}
+		}
+	}
+}
+
+//write - appends entry to the file, rotating the file first if the entry would take it over its maximum size
+func (queryLog *QueryLog) write(entry *QueryLogEntry) (err error) {
+	queryLog.mutex.Lock()
+	defer queryLog.mutex.Unlock()
+	defer func() {
+		if err != nil {
+			queryLog.writeErr = err
+		}
+	}()
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	maxFileBytes := int64(queryLog.config.MaxFileMegabytes) << 20
+	if maxFileBytes > 0 && queryLog.size > 0 && queryLog.size+int64(len(line)) > maxFileBytes {
+		if err = queryLog.rotate(entry.Time); err != nil {
+			return err
+		}
+	}
+	n, err := queryLog.file.Write(line)
+	queryLog.size += int64(n)
+	if err != nil {
+		return errors.Wrap(err, "failed to write query log entry")
+	}
+	return nil
+}
+
+//EraseCaller - removes the searches made by caller in the tenant with tenantID from the query log, including its rotated files
+func (queryLog *QueryLog) EraseCaller(tenantID string, caller string) error {
+	if queryLog == nil || caller == "" {
+		return nil
+	}
+	//Entries recorded before the erasure are written first so that they are erased too
+	queryLog.flush()
+
+	queryLog.mutex.Lock()
+	defer queryLog.mutex.Unlock()
+
+	rotatedPaths, err := queryLog.rotatedPaths()
+	if err != nil {
+		return err
+	}
+	for _, rotatedPath := range rotatedPaths {
+		if err := eraseQueryLogCaller(rotatedPath, tenantID, caller); err != nil {
+			return err
+		}
+	}
+	if err := queryLog.file.Close(); err != nil {
+		return errors.Wrap(err, "failed to erase from query log")
+	}
+	err = eraseQueryLogCaller(queryLog.config.Path, tenantID, caller)
+	if openErr := queryLog.open(); openErr != nil {
+		return openErr
+	}
+	return err
+}
+
+//eraseQueryLogCaller - rewrites the query log file at path without the entries of caller in the tenant with tenantID. Lines which are not entries are kept
+func eraseQueryLogCaller(path string, tenantID string, caller string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return errors.Wrap(err, "failed to erase from query log")
+	}
+	defer file.Close()
+	erasingPath := path + ".erasing"
+	erasingFile, err := os.OpenFile(erasingPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return errors.Wrap(err, "failed to erase from query log")
+	}
+	defer os.Remove(erasingPath)
+
+	reader := bufio.NewReader(file)
+	writer := bufio.NewWriter(erasingFile)
+	for {
+		line, readErr := reader.ReadBytes('\n')
+		if readErr != nil && readErr != io.EOF {
+			erasingFile.Close()
+			return errors.Wrap(readErr, "failed to erase from query log")
+		}
+		var entry QueryLogEntry
+		if len(line) == 0 || (json.Unmarshal(line, &entry) == nil && entry.TenantID == tenantID && entry.Caller == caller) {
+			line = nil
+		}
+		if _, err := writer.Write(line); err != nil {
+			erasingFile.Close()
+			return errors.Wrap(err, "failed to erase from query log")
+		}
+		if readErr == io.EOF {
+			break
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		erasingFile.Close()
+		return errors.Wrap(err, "failed to erase from query log")
+	}
+	if err := erasingFile.Close(); err != nil {
+		return errors.Wrap(err, "failed to erase from query log")
+	}
+	if err := os.Rename(erasingPath, path); err != nil {
+		return errors.Wrap(err, "failed to erase from query log")
+	}
+	return nil
+}
+
+//open - opens the file at the query log's path for appending
+func (queryLog *QueryLog) open() error {
+	file, err := os.OpenFile(queryLog.config.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return errors.Wrap(err, "failed to open query log")
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return errors.Wrap(err, "failed to open query log")
+	}
+	queryLog.file = file
+	queryLog.size = info.Size()
+	return nil
+}
+
+//rotate - renames the current file after rotatedAt, the time of the entry which did not fit, and starts a new one. The caller must hold the mutex
+func (queryLog *QueryLog) rotate(rotatedAt time.Time) error {
+	if err := queryLog.file.Close(); err != nil {
+		return errors.Wrap(err, "failed to rotate query log")
+	}
+	rotatedPath := queryLog.config.Path + "." + rotatedAt.UTC().Format(queryLogRotationTimeFormat)
+	if err := os.Rename(queryLog.config.Path, rotatedPath); err != nil {
+		return errors.Wrap(err, "failed to rotate query log")
+	}
+	if err := queryLog.open(); err != nil {
+		return err
+	}
+	return queryLog.removeOldFiles()
+}
+
+//removeOldFiles - removes the rotated files beyond the number of backups kept, and those older than the retention period. The caller must hold the mutex, if the writer is running
+func (queryLog *QueryLog) removeOldFiles() error {
+	rotatedPaths, err := queryLog.rotatedPaths()
+	if err != nil {
+		return err
+	}
+
+	retainedSince := queryLog.now().AddDate(0, 0, -queryLog.config.RetentionDays)
+	for i, rotatedPath := range rotatedPaths {
+		rotatedAt, _ := time.Parse(queryLogRotationTimeFormat, strings.TrimPrefix(rotatedPath, queryLog.config.Path+"."))
+		tooMany := queryLog.config.MaxBackups > 0 && len(rotatedPaths)-i > queryLog.config.MaxBackups
+		tooOld := queryLog.config.RetentionDays > 0 && rotatedAt.Before(retainedSince)
+		if tooMany || tooOld {
+			if err := os.Remove(rotatedPath); err != nil {
+				return errors.Wrap(err, "failed to remove rotated query log")
+			}
+		}
+	}
+	return nil
+}
+
+//rotatedPaths - returns the paths of the files named by rotate, oldest first
+func (queryLog *QueryLog) rotatedPaths() ([]string, error) {
+	paths, err := filepath.Glob(queryLog.config.Path + ".*")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list rotated query logs")
+	}
+	rotatedPaths := make([]string, 0, len(paths))
+	for _, path := range paths {
+		if _, err := time.Parse(queryLogRotationTimeFormat, strings.TrimPrefix(path, queryLog.config.Path+".")); err == nil {
+			rotatedPaths = append(rotatedPaths, path)
+		}
+	}
+	sort.Strings(rotatedPaths)
+	return rotatedPaths, nil
+}
@@ -0,0 +1,301 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	wordsearchsystemgrpc "github.com/chrisjpalmer/word_search_system/word_search_system_grpc"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+//readQueryLogEntries - returns the entries of the query log file at path
+func readQueryLogEntries(t *testing.T, path string) []QueryLogEntry {
+	file, err := os.Open(path)
+	assert.NoError(t, err)
+	defer file.Close()
+
+	entries := make([]QueryLogEntry, 0)
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64<<10), 1<<20)
+	for scanner.Scan() {
+		var entry QueryLogEntry
+		assert.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func TestQueryLog(t *testing.T) {
+	t.Run("record test", func(t *testing.T) {
+		dir, _ := ioutil.TempDir("", "query_log")
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "queries.jsonl")
+		now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+		queryLog, err := NewQueryLog(QueryLogConfig{Path: path})
+		assert.NoError(t, err)
+		queryLog.now = func() time.Time { return now }
+
+		//it should write each search as a line of JSON
+		assert.NoError(t, queryLog.Record(QueryLogEntry{Caller: "alice", TenantID: "acme", Dictionary: "default", KeyWord: "helo", MatchCount: 2, LatencyMicroseconds: 150, Metadata: map[string]string{"session-id": "s1"}}))
+		assert.NoError(t, queryLog.Record(QueryLogEntry{TenantID: "acme", Dictionary: "default", KeyWord: "hello"}))
+		assert.NoError(t, queryLog.Close())
+		entries := readQueryLogEntries(t, path)
+		assert.Len(t, entries, 2)
+		assert.EqualValues(t, QueryLogEntry{Time: now, Caller: "alice", TenantID: "acme", Dictionary: "default", KeyWord: "helo", MatchCount: 2, LatencyMicroseconds: 150, Metadata: map[string]string{"session-id": "s1"}}, entries[0])
+
+		//it should append to an existing log
+		queryLog, _ = NewQueryLog(QueryLogConfig{Path: path})
+		queryLog.Record(QueryLogEntry{KeyWord: "again"})
+		queryLog.Close()
+		assert.Len(t, readQueryLogEntries(t, path), 3)
+
+		//it should log nothing when there is no query log
+		var noQueryLog *QueryLog
+		assert.NoError(t, noQueryLog.Record(QueryLogEntry{KeyWord: "nothing"}))
+	})
+	t.Run("sampling test", func(t *testing.T) {
+		dir, _ := ioutil.TempDir("", "query_log")
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "queries.jsonl")
+		sampleRate := 0.25
+		queryLog, err := NewQueryLog(QueryLogConfig{Path: path, SampleRate: &sampleRate})
+		assert.NoError(t, err)
+		samples := []float64{0.1, 0.5, 0.24, 0.25}
+		queryLog.sample = func() float64 {
+			sample := samples[0]
+			samples = samples[1:]
+			return sample
+		}
+
+		//it should only log the sampled fraction of searches
+		for _, keyWord := range []string{"a", "b", "c", "d"} {
+			queryLog.Record(QueryLogEntry{KeyWord: keyWord})
+		}
+		queryLog.Close()
+		entries := readQueryLogEntries(t, path)
+		assert.Len(t, entries, 2)
+		assert.EqualValues(t, "a", entries[0].KeyWord)
+		assert.EqualValues(t, "c", entries[1].KeyWord)
+
+		//it should log nothing when the sample rate is 0
+		sampleRate = 0
+		queryLog, err = NewQueryLog(QueryLogConfig{Path: path, SampleRate: &sampleRate})
+		assert.NoError(t, err)
+		queryLog.sample = func() float64 { return 0 }
+		queryLog.Record(QueryLogEntry{KeyWord: "e"})
+		queryLog.Close()
+		assert.Len(t, readQueryLogEntries(t, path), 2)
+
+		//it should reject an invalid config
+		sampleRate = 1.5
+		_, err = NewQueryLog(QueryLogConfig{Path: path, SampleRate: &sampleRate})
+		assert.Error(t, err)
+		_, err = NewQueryLog(QueryLogConfig{Path: path, MaxBackups: -1})
+		assert.Error(t, err)
+		_, err = NewQueryLog(QueryLogConfig{})
+		assert.Error(t, err)
+	})
+	t.Run("rotation test", func(t *testing.T) {
+		dir, _ := ioutil.TempDir("", "query_log")
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "queries.jsonl")
+		now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+		queryLog, err := NewQueryLog(QueryLogConfig{Path: path, MaxFileMegabytes: 1, MaxBackups: 2, RetentionDays: 1})
+		assert.NoError(t, err)
+		queryLog.now = func() time.Time { return now }
+		bigKeyWord := strings.Repeat("x", 400<<10)
+		rotatedFiles := func() []string {
+			paths, _ := filepath.Glob(path + ".*")
+			return paths
+		}
+
+		//it should rotate the file before it grows past its maximum size
+		queryLog.Record(QueryLogEntry{KeyWord: bigKeyWord})
+		queryLog.Record(QueryLogEntry{KeyWord: bigKeyWord})
+		queryLog.flush()
+		assert.Empty(t, rotatedFiles())
+		queryLog.Record(QueryLogEntry{KeyWord: "third"})
+		queryLog.flush()
+		assert.Empty(t, rotatedFiles())
+		queryLog.Record(QueryLogEntry{KeyWord: bigKeyWord})
+		queryLog.flush()
+		assert.EqualValues(t, []string{path + ".20200101T120000.000000000"}, rotatedFiles())
+		assert.Len(t, readQueryLogEntries(t, path), 1)
+
+		//it should keep no more than the maximum number of rotated files
+		for i := 0; i < 4; i++ {
+			queryLog.flush()
+			now = now.Add(time.Hour)
+			queryLog.Record(QueryLogEntry{KeyWord: bigKeyWord})
+			queryLog.Record(QueryLogEntry{KeyWord: bigKeyWord})
+			queryLog.Record(QueryLogEntry{KeyWord: bigKeyWord})
+		}
+		queryLog.flush()
+		assert.EqualValues(t, []string{path + ".20200101T150000.000000000", path + ".20200101T160000.000000000"}, rotatedFiles())
+
+		//it should remove rotated files older than the retention period, leaving other files alone
+		ioutil.WriteFile(path+".notes", []byte("keep"), 0644)
+		now = now.Add(24*time.Hour + 30*time.Minute)
+		queryLog.Record(QueryLogEntry{KeyWord: bigKeyWord})
+		queryLog.Record(QueryLogEntry{KeyWord: bigKeyWord})
+		queryLog.flush()
+		assert.EqualValues(t, []string{path + ".20200102T163000.000000000", path + ".notes"}, rotatedFiles())
+		queryLog.Close()
+	})
+	t.Run("retention timer test", func(t *testing.T) {
+		dir, _ := ioutil.TempDir("", "query_log")
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "queries.jsonl")
+		defer func(interval time.Duration) { queryLogRetentionInterval = interval }(queryLogRetentionInterval)
+		queryLogRetentionInterval = 10 * time.Millisecond
+		now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+		queryLog, err := NewQueryLog(QueryLogConfig{Path: path, RetentionDays: 1})
+		assert.NoError(t, err)
+		queryLog.mutex.Lock()
+		queryLog.now = func() time.Time { return now }
+		queryLog.mutex.Unlock()
+		rotatedPath := path + ".20200101T110000.000000000"
+		ioutil.WriteFile(rotatedPath, []byte("{}\n"), 0644)
+
+		//it should remove rotated files once they are older than the retention period, even when the file is not rotated
+		time.Sleep(50 * time.Millisecond)
+		_, err = os.Stat(rotatedPath)
+		assert.NoError(t, err)
+		queryLog.mutex.Lock()
+		now = now.Add(24 * time.Hour)
+		queryLog.mutex.Unlock()
+		removed := false
+		for i := 0; i < 100 && !removed; i++ {
+			time.Sleep(10 * time.Millisecond)
+			_, err = os.Stat(rotatedPath)
+			removed = os.IsNotExist(err)
+		}
+		assert.True(t, removed)
+		assert.NoError(t, queryLog.Close())
+	})
+	t.Run("erase test", func(t *testing.T) {
+		dir, _ := ioutil.TempDir("", "query_log")
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "queries.jsonl")
+		now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+		queryLog, err := NewQueryLog(QueryLogConfig{Path: path, MaxFileMegabytes: 1})
+		assert.NoError(t, err)
+		queryLog.now = func() time.Time { return now }
+		bigKeyWord := strings.Repeat("x", 400<<10)
+		for _, caller := range []string{"alice", "bob", "alice"} {
+			queryLog.Record(QueryLogEntry{TenantID: "acme", Caller: caller, KeyWord: bigKeyWord})
+		}
+		queryLog.Record(QueryLogEntry{TenantID: "acme", Caller: "alice", KeyWord: "hello"})
+		queryLog.Record(QueryLogEntry{TenantID: "globex", Caller: "alice", KeyWord: "hello"})
+		queryLog.flush()
+		rotatedPath := path + ".20200101T120000.000000000"
+		ioutil.WriteFile(path+".notes", []byte("alice"), 0644)
+
+		//it should remove the caller's entries in the tenant from the current and rotated files, leaving other files alone
+		assert.NoError(t, queryLog.EraseCaller("acme", "alice"))
+		rotated := readQueryLogEntries(t, rotatedPath)
+		if assert.Len(t, rotated, 1) {
+			assert.EqualValues(t, "bob", rotated[0].Caller)
+		}
+		current := readQueryLogEntries(t, path)
+		if assert.Len(t, current, 1) {
+			assert.EqualValues(t, "globex", current[0].TenantID)
+		}
+		notes, _ := ioutil.ReadFile(path + ".notes")
+		assert.EqualValues(t, "alice", string(notes))
+
+		//it should keep logging to the current file afterwards
+		queryLog.Record(QueryLogEntry{TenantID: "acme", Caller: "bob", KeyWord: "again"})
+		assert.NoError(t, queryLog.Close())
+		assert.Len(t, readQueryLogEntries(t, path), 2)
+	})
+	t.Run("buffer test", func(t *testing.T) {
+		dir, _ := ioutil.TempDir("", "query_log")
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "queries.jsonl")
+		queryLog, err := NewQueryLog(QueryLogConfig{Path: path})
+		assert.NoError(t, err)
+
+		//it should drop entries rather than wait when the writer has fallen behind
+		queryLog.mutex.Lock()
+		recorded := 0
+		for ; recorded <= queryLogBufferSize+1; recorded++ {
+			if err = queryLog.Record(QueryLogEntry{KeyWord: "busy"}); err != nil {
+				break
+			}
+		}
+		assert.Equal(t, ErrQueryLogFull, err)
+		assert.True(t, recorded >= queryLogBufferSize)
+		queryLog.mutex.Unlock()
+
+		//it should write the entries waiting to be written when it is closed, and refuse entries after
+		assert.NoError(t, queryLog.Close())
+		assert.Len(t, readQueryLogEntries(t, path), recorded)
+		assert.Equal(t, ErrQueryLogClosed, queryLog.Record(QueryLogEntry{KeyWord: "late"}))
+	})
+}
+
+//fakeSearchClient - a WordSearchSystemClient which answers searches from a table of matches, failing searches for keywords not in it
+type fakeSearchClient struct {
+	wordsearchsystemgrpc.WordSearchSystemClient
+	matches  map[string][]string
+	metadata []metadata.MD
+	requests []*wordsearchsystemgrpc.SearchWordRequest
+}
+
+func (client *fakeSearchClient) SearchWord(ctx context.Context, in *wordsearchsystemgrpc.SearchWordRequest, opts ...grpc.CallOption) (*wordsearchsystemgrpc.SearchWordReply, error) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	client.metadata = append(client.metadata, md)
+	client.requests = append(client.requests, in)
+	matches, ok := client.matches[in.KeyWord]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return &wordsearchsystemgrpc.SearchWordReply{Matches: matches, TotalMatches: int32(len(matches))}, nil
+}
+
+func TestReplayQueryLog(t *testing.T) {
+	client := &fakeSearchClient{matches: map[string][]string{"cat": {"cat", "catalog"}, "dog": {"dog"}}}
+	queryLog := strings.Join([]string{
+		`{"time":"2020-01-01T12:00:00Z","caller":"alice","tenantId":"acme","dictionary":"animals","keyWord":"cat","metadata":{"session-id":"s1"},"matchCount":2}`,
+		``,
+		`{"time":"2020-01-01T12:00:01Z","tenantId":"acme","dictionary":"default","keyWord":"dog","request":{"keyWord":"dog","version":4},"matchCount":3}`,
+		`{"time":"2020-01-01T12:00:02Z","tenantId":"acme","dictionary":"default","keyWord":"bird","matchCount":0}`,
+	}, "\n")
+
+	//it should make each logged search with its metadata, counting failures and changed match counts
+	summary, err := ReplayQueryLog(context.Background(), strings.NewReader(queryLog), client, "")
+	assert.NoError(t, err)
+	assert.EqualValues(t, ReplaySummary{Queries: 3, Failed: 1, MatchCountChanged: 1}, summary)
+	assert.EqualValues(t, []string{"acme"}, client.metadata[0].Get(tenantMetadataKey))
+	assert.EqualValues(t, "animals", client.requests[0].Dictionary)
+	assert.EqualValues(t, []string{"alice"}, client.metadata[0].Get(userMetadataKey))
+	assert.EqualValues(t, []string{"s1"}, client.metadata[0].Get(sessionMetadataKey))
+	assert.Empty(t, client.metadata[1].Get(userMetadataKey))
+	assert.EqualValues(t, 4, client.requests[1].Version)
+	assert.Empty(t, client.metadata[0].Get(authorizationMetadataKey))
+
+	//it should authenticate each search with the API key
+	client.metadata = nil
+	_, err = ReplayQueryLog(context.Background(), strings.NewReader(queryLog), client, "acme-key")
+	assert.NoError(t, err)
+	for _, md := range client.metadata {
+		assert.EqualValues(t, []string{"Bearer acme-key"}, md.Get(authorizationMetadataKey))
+	}
+
+	//it should stop at a line which is not a query log entry
+	summary, err = ReplayQueryLog(context.Background(), strings.NewReader(queryLog+"\nnot json"), client, "")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "line 5")
+	assert.EqualValues(t, 3, summary.Queries)
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"sync"
+	"time"
+)
+
+//rateLimiter - a token bucket which allows requestsPerSecond requests on average, with bursts of up to burst requests
+type rateLimiter struct {
+	mutex             sync.Mutex
+	requestsPerSecond float64
+	burst             float64
+	tokens            float64
+	lastRefill        time.Time
+	now               func() time.Time
+}
+
+//newRateLimiter - creates a new rateLimiter with a full bucket. A requestsPerSecond of 0 or less means requests are never limited
+func newRateLimiter(requestsPerSecond float64, burst int, now func() time.Time) *rateLimiter {
+	if burst <= 0 {
+		burst = 1
+	}
+	newRateLimiter := new(rateLimiter)
+	newRateLimiter.requestsPerSecond = requestsPerSecond
+	newRateLimiter.burst = float64(burst)
+	newRateLimiter.tokens = float64(burst)
+	newRateLimiter.now = now
+	newRateLimiter.lastRefill = now()
+	return newRateLimiter
+}
+
+//Allow - takes a token from the bucket, returning false if the bucket is empty
+func (rateLimiter *rateLimiter) Allow() bool {
+	if rateLimiter.requestsPerSecond <= 0 {
+		return true
+	}
+
+	rateLimiter.mutex.Lock()
+	defer rateLimiter.mutex.Unlock()
+
+	//Refill the bucket for the time which has passed since the last request
+	now := rateLimiter.now()
+	rateLimiter.tokens += now.Sub(rateLimiter.lastRefill).Seconds() * rateLimiter.requestsPerSecond
+	if rateLimiter.tokens > rateLimiter.burst {
+		rateLimiter.tokens = rateLimiter.burst
+	}
+	rateLimiter.lastRefill = now
+
+	if rateLimiter.tokens < 1 {
+		return false
+	}
+	rateLimiter.tokens--
+	return true
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	wordsearchsystemgrpc "github.com/chrisjpalmer/word_search_system/word_search_system_grpc"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+)
+
+//ReplaySummary - the outcome of replaying a query log
+type ReplaySummary struct {
+	//Queries - the number of searches replayed
+	Queries int
+	//Failed - the number of searches the server answered with an error
+	Failed int
+	//MatchCountChanged - the number of searches which matched a different number of words than when they were logged
+	MatchCountChanged int
+}
+
+//ReplayQueryLog - makes each search in the query log read from reader against client, one after another, with the metadata it was logged with.
+// Searches authenticate with apiKey, which must belong to the tenant each search was logged for. An empty apiKey replays searches for tenants without API keys
+func ReplayQueryLog(ctx context.Context, reader io.Reader, client wordsearchsystemgrpc.WordSearchSystemClient, apiKey string) (summary ReplaySummary, err error) {
+	//Lines are read whole, as a search for a long keyword makes a long line
+	bufferedReader := bufio.NewReader(reader)
+	for lineNumber := 1; ; lineNumber++ {
+		line, err := bufferedReader.ReadBytes('\n')
+		if err == io.EOF && len(line) == 0 {
+			return summary, nil
+		}
+		if err != nil && err != io.EOF {
+			return summary, errors.Wrap(err, "failed to read query log")
+		}
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		var entry QueryLogEntry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return summary, errors.Wrap(err, fmt.Sprintf("line %d", lineNumber))
+		}
+		if err := ctx.Err(); err != nil {
+			return summary, err
+		}
+
+		md := metadata.Pairs(tenantMetadataKey, entry.TenantID)
+		if entry.Caller != "" {
+			md.Set(userMetadataKey, entry.Caller)
+		}
+		for key, value := range entry.Metadata {
+			md.Set(key, value)
+		}
+		if apiKey != "" {
+			md.Set(authorizationMetadataKey, "Bearer "+apiKey)
+		}
+
+		//Entries logged before requests were kept are replayed from their keyword and dictionary
+		request := entry.Request
+		if request == nil {
+			request = &wordsearchsystemgrpc.SearchWordRequest{KeyWord: entry.KeyWord, Dictionary: entry.Dictionary}
+		}
+
+		summary.Queries++
+		reply, err := client.SearchWord(metadata.NewOutgoingContext(ctx, md), request)
+		if err != nil {
+			summary.Failed++
+			continue
+		}
+		if int(reply.TotalMatches) != entry.MatchCount {
+			summary.MatchCountChanged++
+		}
+	}
+}
+
+//ReplayQueryLogFile - replays the query log at queryLogPath against client, authenticating with apiKey, see ReplayQueryLog
+func ReplayQueryLogFile(ctx context.Context, queryLogPath string, client wordsearchsystemgrpc.WordSearchSystemClient, apiKey string) (summary ReplaySummary, err error) {
+	file, err := os.Open(queryLogPath)
+	if err != nil {
+		return summary, err
+	}
+	defer file.Close()
+
+	return ReplayQueryLog(ctx, file, client, apiKey)
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"math"
+	"unicode/utf8"
+)
+
+//ScoreCandidate - a word matched by a search, along with what is known about the match
+type ScoreCandidate struct {
+	//KeyWord - the lowercase keyword which was searched for, or the synonym the word matched through. It is not stemmed for a stemmed search
+	KeyWord string
+	//Word - the matched word
+	Word string
+	//MatchPosition - the character offset in Word at which the match starts. 0 for a prefix match, or when a stemmed match does not contain KeyWord
+	MatchPosition int
+	//TokenPosition - the index of the token of Word in which the match starts, e.g. 1 for "cre" in "ice cream"
+	TokenPosition int
+	//TokenBoundary - whether the match starts at the start of a token of Word
+	TokenBoundary bool
+	//Popularity - the number of times Word has itself been searched for
+	Popularity int64
+}
+
+//Scorer - scores how relevant a matched word is to a search. Higher scores are more relevant
+type Scorer interface {
+	Score(candidate ScoreCandidate) float64
+}
+
+//WeightedScorer - a Scorer which adds up weighted signals about the match. The weights can be tuned to change the ranking
+type WeightedScorer struct {
+	//ExactMatchWeight - added when the word is the keyword
+	ExactMatchWeight float64
+	//PrefixMatchWeight - added when the word starts with the keyword
+	PrefixMatchWeight float64
+	//TokenPrefixMatchWeight - added when a later token of the word starts with the keyword, as "cream" does in "ice cream"
+	TokenPrefixMatchWeight float64
+	//TokenPositionWeight - subtracted for each token before the token the match starts at, in place of MatchPositionWeight
+	TokenPositionWeight float64
+	//MatchPositionWeight - subtracted for each character the keyword appears after the start of the word, when it does not start a token
+	MatchPositionWeight float64
+	//LengthDifferenceWeight - subtracted for each character the word is longer or shorter than the keyword
+	LengthDifferenceWeight float64
+	//PopularityWeight - multiplied by the log of the number of times the word has been searched for
+	PopularityWeight float64
+}
+
+//DefaultScorer - the Scorer used when ranking by relevance without naming a Scorer
+var DefaultScorer Scorer = &WeightedScorer{
+	ExactMatchWeight:       10,
+	PrefixMatchWeight:      5,
+	TokenPrefixMatchWeight: 3,
+	TokenPositionWeight:    0.5,
+	MatchPositionWeight:    0.5,
+	LengthDifferenceWeight: 0.25,
+	PopularityWeight:       1,
+}
+
+//Score - scores the candidate using the scorer's weights
+func (weightedScorer *WeightedScorer) Score(candidate ScoreCandidate) float64 {
+	score := 0.0
+	if candidate.Word == candidate.KeyWord {
+		score += weightedScorer.ExactMatchWeight
+	}
+	switch {
+	case candidate.MatchPosition == 0:
+		score += weightedScorer.PrefixMatchWeight
+	case candidate.TokenBoundary:
+		//A match at the start of a later token is penalized by the tokens before it rather than the characters before it
+		score += weightedScorer.TokenPrefixMatchWeight
+		score -= weightedScorer.TokenPositionWeight * float64(candidate.TokenPosition)
+	default:
+		score -= weightedScorer.MatchPositionWeight * float64(candidate.MatchPosition)
+	}
+	score -= weightedScorer.LengthDifferenceWeight * math.Abs(float64(utf8.RuneCountInString(candidate.Word)-utf8.RuneCountInString(candidate.KeyWord)))
+	score += weightedScorer.PopularityWeight * math.Log1p(float64(candidate.Popularity))
+	return score
+}
+
+//scoreCandidate - describes the match of word against the lowercase keyword starting at byte offset matchPosition. The dictionary must be locked
+func (wordDictionary *WordDictionary) scoreCandidate(lowercaseKeyWord string, word string, matchPosition int) ScoreCandidate {
+	candidate := ScoreCandidate{
+		KeyWord:       lowercaseKeyWord,
+		Word:          word,
+		MatchPosition: utf8.RuneCountInString(word[:matchPosition]),
+		TokenBoundary: matchPosition == 0,
+	}
+	for _, token := range tokenizeText(word) {
+		if token.byteStart == matchPosition {
+			candidate.TokenBoundary = true
+		}
+		if token.byteStart >= matchPosition {
+			break
+		}
+		candidate.TokenPosition++
+	}
+	if matchPosition > 0 && candidate.TokenPosition > 0 && !candidate.TokenBoundary {
+		//The match starts inside the token before
+		candidate.TokenPosition--
+	}
+	if stat := wordDictionary.keyWordStatsMap[word]; stat != nil {
+		candidate.Popularity = stat.numberOfTimesSearched
+	}
+	return candidate
+}
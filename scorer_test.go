@@ -0,0 +1,101 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//constantScorer - a Scorer which scores words by a fixed table
+type constantScorer map[string]float64
+
+func (scorer constantScorer) Score(candidate ScoreCandidate) float64 {
+	return scorer[candidate.Word]
+}
+
+func TestRelevanceRanking(t *testing.T) {
+	t.Run("default scorer test", func(t *testing.T) {
+		wordDictionary := NewWordDictionary("test")
+		wordDictionary.AddWords([]string{"researcher", "searches", "search", "researching", "searchlight"})
+
+		//it should rank the exact match first, then prefix matches, then infix matches
+		results, err := wordDictionary.Search("search", SearchOptions{RankByRelevance: true})
+		assert.NoError(t, err)
+		assert.EqualValues(t, []string{"search", "searches", "searchlight", "researcher", "researching"}, matchWords(results.Matches))
+
+		//it should return the scores, highest first
+		for i := 1; i < len(results.Matches); i++ {
+			assert.True(t, results.Matches[i-1].Score >= results.Matches[i].Score)
+		}
+
+		//it should still order the matches alphabetically when not ranking
+		results, _ = wordDictionary.Search("search", SearchOptions{})
+		assert.EqualValues(t, []string{"researcher", "researching", "search", "searches", "searchlight"}, matchWords(results.Matches))
+		assert.EqualValues(t, 0, results.Matches[0].Score)
+	})
+	t.Run("non-ascii test", func(t *testing.T) {
+		scorer := DefaultScorer
+
+		//it should count characters rather than bytes in the word's length
+		assert.EqualValues(t, scorer.Score(ScoreCandidate{KeyWord: "caf", Word: "cafe"}), scorer.Score(ScoreCandidate{KeyWord: "caf", Word: "café"}))
+
+		//it should count characters rather than bytes before an infix match
+		wordDictionary := NewWordDictionary("test")
+		wordDictionary.AddWords([]string{"éélan", "aalan"})
+		results, _ := wordDictionary.Search("lan", SearchOptions{RankByRelevance: true})
+		assert.EqualValues(t, []string{"aalan", "éélan"}, matchWords(results.Matches))
+		assert.EqualValues(t, results.Matches[0].Score, results.Matches[1].Score)
+	})
+	t.Run("stemmed test", func(t *testing.T) {
+		wordDictionary := NewWordDictionary("test")
+		wordDictionary.AddWords([]string{"run", "runs", "running", "runner"})
+
+		//it should rank the word searched for above the other forms of it, and shorter forms above longer ones
+		results, err := wordDictionary.Search("running", SearchOptions{RankByRelevance: true, Stemming: true})
+		assert.NoError(t, err)
+		assert.EqualValues(t, []string{"running", "runs", "run"}, matchWords(results.Matches))
+	})
+	t.Run("popularity test", func(t *testing.T) {
+		wordDictionary := NewWordDictionary("test")
+		wordDictionary.AddWords([]string{"cart", "card"})
+
+		//it should rank more frequently searched words higher among otherwise equal matches
+		for i := 0; i < 5; i++ {
+			wordDictionary.SearchWord("cart")
+		}
+		results, _ := wordDictionary.Search("car", SearchOptions{RankByRelevance: true})
+		assert.EqualValues(t, []string{"cart", "card"}, matchWords(results.Matches))
+	})
+	t.Run("pluggable scorer test", func(t *testing.T) {
+		wordDictionary := NewWordDictionary("test")
+		wordDictionary.AddWords([]string{"apple", "maple", "grapple", "applet"})
+		scorer := constantScorer{"apple": 1, "maple": 3, "grapple": 2, "applet": 2}
+
+		//it should rank by the given scorer, breaking ties alphabetically
+		results, _ := wordDictionary.Search("ple", SearchOptions{RankByRelevance: true, Scorer: scorer})
+		assert.EqualValues(t, []string{"maple", "applet", "grapple", "apple"}, matchWords(results.Matches))
+
+		//it should page through ranked results without skipping or repeating words
+		words := make([]string, 0)
+		options := SearchOptions{RankByRelevance: true, Scorer: scorer, PageSize: 1}
+		for {
+			results, err := wordDictionary.Search("ple", options)
+			assert.NoError(t, err)
+			words = append(words, matchWords(results.Matches)...)
+			if results.NextPageToken == "" {
+				break
+			}
+			options.PageToken = results.NextPageToken
+		}
+		assert.EqualValues(t, []string{"maple", "applet", "grapple", "apple"}, words)
+	})
+}
+
+//matchWords - returns the words of matches
+func matchWords(matches []SearchMatch) []string {
+	words := make([]string, len(matches))
+	for i := range matches {
+		words[i] = matches[i].Word
+	}
+	return words
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"sort"
+)
+
+//searchPageCursor - the position in a search's matches that a page starts after.
+// Pages are keyed by the last word returned rather than an offset, so words added between pages do not cause matches to be skipped or repeated.
+// Paging through ranked matches is best-effort: scores are worked out afresh for each page, and a word searched for between pages
+// can rise above the cursor and be skipped. As popularity only grows, a word is never repeated
+type searchPageCursor struct {
+	LastWord  string  `json:"w"`
+	LastScore float64 `json:"s,omitempty"`
+}
+
+//encodeSearchPageToken - creates an opaque page token from cursor. A nil cursor produces ""
+func encodeSearchPageToken(cursor *searchPageCursor) string {
+	if cursor == nil {
+		return ""
+	}
+	encoded, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(encoded)
+}
+
+//decodeSearchPageToken - returns the cursor a page token was created from. An empty token produces a nil cursor
+func decodeSearchPageToken(pageToken string) (cursor *searchPageCursor, err error) {
+	if pageToken == "" {
+		return nil, nil
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	cursor = new(searchPageCursor)
+	if err = json.Unmarshal(decoded, cursor); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	return cursor, nil
+}
+
+//matchOrderLess - reports whether match a comes before match b. Ranked matches are ordered by score, highest first, and then alphabetically
+func matchOrderLess(a SearchMatch, b SearchMatch, ranked bool) bool {
+	if ranked && a.Score != b.Score {
+		return a.Score > b.Score
+	}
+	return a.Word < b.Word
+}
+
+//searchPage - returns the page of the sorted matches after cursor, along with the cursor of the next page (nil if this is the last page)
+func searchPage(matches []SearchMatch, cursor *searchPageCursor, pageSize int, ranked bool) (page []SearchMatch, nextCursor *searchPageCursor) {
+	if cursor != nil {
+		last := SearchMatch{Word: cursor.LastWord, Score: cursor.LastScore}
+		start := sort.Search(len(matches), func(i int) bool { return matchOrderLess(last, matches[i], ranked) })
+		matches = matches[start:]
+	}
+	if pageSize <= 0 || len(matches) <= pageSize {
+		return matches, nil
+	}
+	last := matches[pageSize-1]
+	return matches[:pageSize], &searchPageCursor{LastWord: last.Word, LastScore: last.Score}
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWordDictionary_SearchPagination(t *testing.T) {
+	t.Run("basic test", func(t *testing.T) {
+		wordDictionary := NewWordDictionary("test")
+		wordDictionary.AddWords([]string{"bean", "beef", "beer", "beet", "bees"})
+
+		//it should return the first page along with the total number of matches
+		searchResults, err := wordDictionary.Search("be", SearchOptions{PageSize: 2})
+		assert.NoError(t, err)
+		assert.EqualValues(t, []SearchMatch{{Word: "bean"}, {Word: "beef"}}, searchResults.Matches)
+		assert.EqualValues(t, 5, searchResults.TotalMatches)
+		assert.NotEqual(t, "", searchResults.NextPageToken)
+
+		//it should continue from where the previous page ended
+		searchResults, _ = wordDictionary.Search("be", SearchOptions{PageSize: 2, PageToken: searchResults.NextPageToken})
+		assert.EqualValues(t, []SearchMatch{{Word: "beer"}, {Word: "bees"}}, searchResults.Matches)
+		searchResults, _ = wordDictionary.Search("be", SearchOptions{PageSize: 2, PageToken: searchResults.NextPageToken})
+		assert.EqualValues(t, []SearchMatch{{Word: "beet"}}, searchResults.Matches)
+		assert.EqualValues(t, "", searchResults.NextPageToken)
+
+		//it should only count the search once in the statistics
+		assert.EqualValues(t, []string{"be"}, wordDictionary.Top5SearchKeyWords())
+		assert.EqualValues(t, 1, wordDictionary.keyWordStatsMap["be"].numberOfTimesSearched)
+
+		//it should refuse page tokens it did not produce
+		_, err = wordDictionary.Search("be", SearchOptions{PageSize: 2, PageToken: "???"})
+		assert.Error(t, err)
+	})
+	t.Run("stable under concurrent AddWords", func(t *testing.T) {
+		wordDictionary := NewWordDictionary("test")
+		wordDictionary.AddWords([]string{"bean", "beef", "beer", "beet"})
+
+		searchResults, _ := wordDictionary.Search("be", SearchOptions{PageSize: 2})
+
+		//it should neither repeat nor skip matches when words are added before or after the page boundary
+		wordDictionary.AddWords([]string{"beach", "beetle"})
+		searchResults, _ = wordDictionary.Search("be", SearchOptions{PageSize: 2, PageToken: searchResults.NextPageToken})
+		assert.EqualValues(t, []SearchMatch{{Word: "beer"}, {Word: "beet"}}, searchResults.Matches)
+		assert.EqualValues(t, 6, searchResults.TotalMatches)
+		searchResults, _ = wordDictionary.Search("be", SearchOptions{PageSize: 2, PageToken: searchResults.NextPageToken})
+		assert.EqualValues(t, []SearchMatch{{Word: "beetle"}}, searchResults.Matches)
+	})
+	t.Run("ranked pages are best-effort", func(t *testing.T) {
+		wordDictionary := NewWordDictionary("test")
+		wordDictionary.AddWords([]string{"bean", "beef", "beer", "beet"})
+		options := SearchOptions{RankByRelevance: true, PageSize: 2}
+
+		searchResults, _ := wordDictionary.Search("be", options)
+		assert.EqualValues(t, []string{"bean", "beef"}, matchWords(searchResults.Matches))
+
+		//it should skip, but not repeat, a word which rises above the page boundary between pages
+		wordDictionary.SearchWord("beet")
+		options.PageToken = searchResults.NextPageToken
+		searchResults, _ = wordDictionary.Search("be", options)
+		assert.EqualValues(t, []string{"beer"}, matchWords(searchResults.Matches))
+		assert.EqualValues(t, "", searchResults.NextPageToken)
+
+		//it should rank the word in its new place on a fresh search
+		options.PageToken = ""
+		searchResults, _ = wordDictionary.Search("be", options)
+		assert.EqualValues(t, []string{"beet", "bean"}, matchWords(searchResults.Matches))
+	})
+}
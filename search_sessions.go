@@ -0,0 +1,160 @@
+package main
+
+import (
+	"sort"
+	"time"
+	"unicode/utf8"
+)
+
+//maxQueriesPerSession - the number of most recent queries kept for a search session
+const maxQueriesPerSession = 20
+
+//searchSessionIdleTimeout - how long a search session is kept after its last query
+const searchSessionIdleTimeout = 30 * time.Minute
+
+//refinementWindow - the longest gap between two queries of a session for the second to count as a refinement of the first
+const refinementWindow = time.Minute
+
+//SessionQuery - a keyword searched for in a search session
+type SessionQuery struct {
+	KeyWord    string
+	SearchedAt time.Time
+}
+
+//QueryRefinement - a keyword which was searched for soon after another in the same search session, such as "hello" after "helo"
+type QueryRefinement struct {
+	From string
+	To   string
+	//Count - the number of times To was searched for straight after From
+	Count int64
+	//EditDistance - the edit distance between From and To, so that spelling corrections can be told from other refinements
+	EditDistance int
+}
+
+//QueryRefinementsOptions - optional parameters for QueryRefinements
+type QueryRefinementsOptions struct {
+	//MinCount - when not 0, only refinements made at least this many times are returned
+	MinCount int64
+	//MaxEditDistance - when not 0, only refinements within this edit distance are returned
+	MaxEditDistance int
+	//Limit - when not 0, at most this many refinements are returned
+	Limit int
+}
+
+//queryRefinementPair - the keywords of a QueryRefinement
+type queryRefinementPair struct {
+	from string
+	to   string
+}
+
+//searchSessions - the queries of each search session and the refinements made across all of them
+type searchSessions struct {
+	queries map[string][]SessionQuery
+	//users - the user who made each session's queries, for the sessions made by a known user
+	users       map[string]string
+	refinements map[queryRefinementPair]int64
+	//nextSweep - when idle sessions are next removed
+	nextSweep time.Time
+}
+
+//newSearchSessions - creates an empty searchSessions
+func newSearchSessions() *searchSessions {
+	newSearchSessions := new(searchSessions)
+	newSearchSessions.queries = make(map[string][]SessionQuery)
+	newSearchSessions.users = make(map[string]string)
+	newSearchSessions.refinements = make(map[queryRefinementPair]int64)
+	return newSearchSessions
+}
+
+//record - adds a search for the lowercase keyword at time now to the session with sessionID, counting a refinement if it closely follows a different keyword.
+// userID is the user who searched, which may be empty
+func (sessions *searchSessions) record(sessionID string, userID string, lowercaseKeyWord string, now time.Time) {
+	//Remove idle sessions every so often, so that sessions which are never searched again do not build up
+	if !now.Before(sessions.nextSweep) {
+		for id, queries := range sessions.queries {
+			if now.Sub(queries[len(queries)-1].SearchedAt) > searchSessionIdleTimeout {
+				delete(sessions.queries, id)
+				delete(sessions.users, id)
+			}
+		}
+		sessions.nextSweep = now.Add(searchSessionIdleTimeout)
+	}
+
+	queries := sessions.queries[sessionID]
+	if len(queries) > 0 {
+		previous := queries[len(queries)-1]
+		if previous.KeyWord != lowercaseKeyWord && now.Sub(previous.SearchedAt) <= refinementWindow {
+			sessions.refinements[queryRefinementPair{from: previous.KeyWord, to: lowercaseKeyWord}]++
+		}
+		if now.Sub(previous.SearchedAt) > searchSessionIdleTimeout {
+			//The session went idle, so this query starts it afresh
+			queries = nil
+		}
+	}
+	queries = append(queries, SessionQuery{KeyWord: lowercaseKeyWord, SearchedAt: now})
+	if len(queries) > maxQueriesPerSession {
+		queries = append([]SessionQuery(nil), queries[len(queries)-maxQueriesPerSession:]...)
+	}
+	sessions.queries[sessionID] = queries
+	if userID != "" {
+		sessions.users[sessionID] = userID
+	}
+}
+
+//erase - forgets the sessions of the user with userID
+func (sessions *searchSessions) erase(userID string) {
+	for sessionID, sessionUserID := range sessions.users {
+		if sessionUserID == userID {
+			delete(sessions.queries, sessionID)
+			delete(sessions.users, sessionID)
+		}
+	}
+}
+
+//SessionQueries - returns the queries of the search session with sessionID, oldest first. Sessions are forgotten once idle for 30 minutes
+func (wordDictionary *WordDictionary) SessionQueries(sessionID string) []SessionQuery {
+	wordDictionary.mutex.Lock()
+	defer wordDictionary.mutex.Unlock()
+
+	queries := wordDictionary.searchSessions.queries[sessionID]
+	if len(queries) == 0 || wordDictionary.now().Sub(queries[len(queries)-1].SearchedAt) > searchSessionIdleTimeout {
+		return []SessionQuery{}
+	}
+	return append([]SessionQuery{}, queries...)
+}
+
+//QueryRefinements - returns the keywords searched for within a minute of a different keyword in the same search session, the most frequent first
+func (wordDictionary *WordDictionary) QueryRefinements(options QueryRefinementsOptions) []QueryRefinement {
+	wordDictionary.mutex.Lock()
+	defer wordDictionary.mutex.Unlock()
+
+	refinements := make([]QueryRefinement, 0, len(wordDictionary.searchSessions.refinements))
+	for pair, count := range wordDictionary.searchSessions.refinements {
+		if count < options.MinCount {
+			continue
+		}
+		//Without a maximum the distance is worked out in full
+		maxDistance := options.MaxEditDistance
+		if maxDistance == 0 {
+			maxDistance = utf8.RuneCountInString(pair.from) + utf8.RuneCountInString(pair.to)
+		}
+		distance := editDistance([]rune(pair.from), []rune(pair.to), maxDistance)
+		if distance > maxDistance {
+			continue
+		}
+		refinements = append(refinements, QueryRefinement{From: pair.from, To: pair.to, Count: count, EditDistance: distance})
+	}
+	sort.Slice(refinements, func(i, j int) bool {
+		if refinements[i].Count != refinements[j].Count {
+			return refinements[i].Count > refinements[j].Count
+		}
+		if refinements[i].From != refinements[j].From {
+			return refinements[i].From < refinements[j].From
+		}
+		return refinements[i].To < refinements[j].To
+	})
+	if options.Limit > 0 && len(refinements) > options.Limit {
+		refinements = refinements[:options.Limit]
+	}
+	return refinements
+}
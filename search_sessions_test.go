@@ -0,0 +1,72 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryRefinements(t *testing.T) {
+	t.Run("refinement test", func(t *testing.T) {
+		now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+		wordDictionary := NewWordDictionary("test")
+		wordDictionary.now = func() time.Time { return now }
+		search := func(sessionID string, keyWord string, after time.Duration) {
+			now = now.Add(after)
+			wordDictionary.Search(keyWord, SearchOptions{SessionID: sessionID})
+		}
+
+		search("a", "helo", 0)
+		search("a", "hello", 5*time.Second)
+		search("b", "helo", 0)
+		search("b", "Hello", 2*time.Second)
+		search("b", "hello", time.Second)
+		search("b", "world", 2*time.Minute)
+		search("c", "helo", 0)
+		search("c", "help", 10*time.Second)
+		search("", "hello", 0)
+
+		//it should count the keywords searched soon after a different keyword in the same session
+		assert.EqualValues(t, []QueryRefinement{
+			{From: "helo", To: "hello", Count: 2, EditDistance: 1},
+			{From: "helo", To: "help", Count: 1, EditDistance: 1},
+		}, wordDictionary.QueryRefinements(QueryRefinementsOptions{}))
+
+		//it should filter the refinements by count and edit distance, up to the limit
+		assert.EqualValues(t, []QueryRefinement{{From: "helo", To: "hello", Count: 2, EditDistance: 1}}, wordDictionary.QueryRefinements(QueryRefinementsOptions{MinCount: 2}))
+		assert.EqualValues(t, "helo", wordDictionary.QueryRefinements(QueryRefinementsOptions{Limit: 1})[0].From)
+		search("d", "cat", 0)
+		search("d", "elephant", time.Second)
+		assert.Len(t, wordDictionary.QueryRefinements(QueryRefinementsOptions{}), 3)
+		assert.Len(t, wordDictionary.QueryRefinements(QueryRefinementsOptions{MaxEditDistance: 2}), 2)
+
+		//it should keep each session's queries in order
+		queries := wordDictionary.SessionQueries("b")
+		assert.EqualValues(t, []string{"helo", "hello", "hello", "world"}, []string{queries[0].KeyWord, queries[1].KeyWord, queries[2].KeyWord, queries[3].KeyWord})
+		assert.EqualValues(t, 2*time.Minute, queries[3].SearchedAt.Sub(queries[2].SearchedAt))
+		assert.Empty(t, wordDictionary.SessionQueries("unknown"))
+	})
+	t.Run("session bounds test", func(t *testing.T) {
+		now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+		wordDictionary := NewWordDictionary("test")
+		wordDictionary.now = func() time.Time { return now }
+		for i := 0; i < maxQueriesPerSession+5; i++ {
+			wordDictionary.Search(fmt.Sprintf("word%02d", i), SearchOptions{SessionID: "a"})
+		}
+
+		//it should keep only the most recent queries of a session
+		queries := wordDictionary.SessionQueries("a")
+		assert.Len(t, queries, maxQueriesPerSession)
+		assert.EqualValues(t, "word05", queries[0].KeyWord)
+
+		//it should forget a session once it is idle, and start it afresh if it is searched again
+		now = now.Add(searchSessionIdleTimeout + time.Second)
+		assert.Empty(t, wordDictionary.SessionQueries("a"))
+		wordDictionary.Search("again", SearchOptions{SessionID: "b"})
+		assert.NotContains(t, wordDictionary.searchSessions.queries, "a")
+		wordDictionary.Search("again", SearchOptions{SessionID: "b"})
+		assert.Len(t, wordDictionary.SessionQueries("b"), 2)
+	})
+}
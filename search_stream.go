@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+//defaultStreamBatchSize - the number of matches sent per batch when the caller does not specify a batch size
+const defaultStreamBatchSize = 100
+
+//streamScanStep - the number of words scanned while holding the dictionary mutex. Cancellation is checked between steps
+const streamScanStep = 1000
+
+//StreamSearch - sends the matches for the keyword provided to send in alphabetical batches of batchSize, as the dictionary is scanned.
+// The dictionary is not locked while a batch is being sent, so words added during the scan are included if they come after the scan position.
+// The scan stops with ctx's error as soon as ctx is cancelled, e.g. when the client hangs up
+func (wordDictionary *WordDictionary) StreamSearch(ctx context.Context, keyWord string, options SearchOptions, batchSize int, send func(matches []SearchMatch) error) error {
+	if options.Version != 0 || options.PageSize != 0 || options.PageToken != "" || options.RankByRelevance {
+		return errors.New("streaming search does not support versions, pages or ranking")
+	}
+	if batchSize <= 0 {
+		batchSize = defaultStreamBatchSize
+	}
+
+	//convert the keyword to lowercase and record it as being searched
+	lowercaseKeyWord := normalizeWord(keyWord)
+	wordDictionary.mutex.Lock()
+	wordDictionary.recordKeyWord(lowercaseKeyWord, options)
+	wordDictionary.mutex.Unlock()
+
+	batch := make([]SearchMatch, 0, batchSize)
+	lastScannedWord := ""
+	for done := false; !done; {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		//Scan the next step of the dictionary
+		var matches []SearchMatch
+		matches, lastScannedWord, done = wordDictionary.scanStep(lowercaseKeyWord, options, lastScannedWord)
+		batch = append(batch, matches...)
+
+		//Send every full batch, and whatever is left once the scan is done
+		for len(batch) >= batchSize || (done && len(batch) > 0) {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			size := batchSize
+			if size > len(batch) {
+				size = len(batch)
+			}
+			if err := send(batch[:size]); err != nil {
+				return err
+			}
+			batch = append(batch[:0:0], batch[size:]...)
+		}
+	}
+	return nil
+}
+
+//scanStep - scans up to streamScanStep words of the sorted index which come after lastScannedWord, returning the matches found, the last word scanned and whether the end of the index was reached
+func (wordDictionary *WordDictionary) scanStep(lowercaseKeyWord string, options SearchOptions, lastScannedWord string) (matches []SearchMatch, _lastScannedWord string, done bool) {
+	wordDictionary.mutex.Lock()
+	defer wordDictionary.mutex.Unlock()
+
+	matcher := wordDictionary.newWordMatcher(lowercaseKeyWord, options)
+	start := wordDictionary.sortedWords.after(lastScannedWord)
+	end := start + streamScanStep
+	if end >= len(wordDictionary.sortedWords) {
+		end = len(wordDictionary.sortedWords)
+		done = true
+	}
+	if start >= end {
+		return nil, lastScannedWord, true
+	}
+
+	matches = make([]SearchMatch, 0)
+	for _, dictionaryWord := range wordDictionary.sortedWords[start:end] {
+		entry := wordDictionary.dictionaryWords[dictionaryWord]
+		if expandedFrom, ok := matcher.match(dictionaryWord, entry); ok {
+			match := SearchMatch{Word: dictionaryWord, ExpandedFrom: expandedFrom}
+			if options.IncludeMetadata {
+				match.Metadata = entry.metadata.clone()
+			}
+			if options.IncludeHighlights {
+				match.Highlights = matcher.highlights(dictionaryWord, expandedFrom)
+			}
+			matches = append(matches, match)
+		}
+	}
+	return matches, wordDictionary.sortedWords[end-1], done
+}
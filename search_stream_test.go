@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWordDictionary_StreamSearch(t *testing.T) {
+	t.Run("basic test", func(t *testing.T) {
+		wordDictionary := NewWordDictionary("test")
+		wordDictionary.AddWords([]string{"bean", "beef", "beer", "beet", "bees", "cat"})
+
+		//it should send the matches in alphabetical batches
+		batches := make([][]string, 0)
+		err := wordDictionary.StreamSearch(context.Background(), "be", SearchOptions{}, 2, func(matches []SearchMatch) error {
+			words := make([]string, len(matches))
+			for i := range matches {
+				words[i] = matches[i].Word
+			}
+			batches = append(batches, words)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.EqualValues(t, [][]string{{"bean", "beef"}, {"beer", "bees"}, {"beet"}}, batches)
+
+		//it should count the search once in the statistics
+		assert.EqualValues(t, []string{"be"}, wordDictionary.Top5SearchKeyWords())
+	})
+	t.Run("large dictionary", func(t *testing.T) {
+		wordDictionary := NewWordDictionary("test")
+		words := make([]string, 0, 2500)
+		for i := 0; i < 2500; i++ {
+			words = append(words, fmt.Sprintf("word%04d", i))
+		}
+		wordDictionary.AddWords(words)
+
+		//it should find every match across several scan steps, in order
+		found := make([]string, 0)
+		wordDictionary.StreamSearch(context.Background(), "9", SearchOptions{}, 50, func(matches []SearchMatch) error {
+			for i := range matches {
+				found = append(found, matches[i].Word)
+			}
+			return nil
+		})
+		assert.EqualValues(t, wordDictionary.SearchWord("9"), found)
+	})
+	t.Run("cancellation", func(t *testing.T) {
+		wordDictionary := NewWordDictionary("test")
+		wordDictionary.AddWords([]string{"bean", "beef", "beer", "beet", "bees"})
+
+		//it should stop as soon as the context is cancelled
+		ctx, cancel := context.WithCancel(context.Background())
+		numberOfBatches := 0
+		err := wordDictionary.StreamSearch(ctx, "be", SearchOptions{}, 1, func(matches []SearchMatch) error {
+			numberOfBatches++
+			cancel()
+			return nil
+		})
+		assert.Equal(t, context.Canceled, err)
+		assert.EqualValues(t, 1, numberOfBatches)
+
+		//it should stop when send fails
+		sendErr := fmt.Errorf("client went away")
+		err = wordDictionary.StreamSearch(context.Background(), "be", SearchOptions{}, 1, func(matches []SearchMatch) error {
+			return sendErr
+		})
+		assert.Equal(t, sendErr, err)
+	})
+}
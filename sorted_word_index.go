@@ -0,0 +1,70 @@
+package main
+
+import (
+	"sort"
+)
+
+//sortedWordIndex - the dictionary words in alphabetical order, so that words can be visited in order without sorting the whole dictionary
+type sortedWordIndex []string
+
+//insertAll - adds words to the index, keeping it sorted. The words are sorted and merged into the index in one pass from its end,
+// so adding many words at once costs about the same as adding one, rather than moving the index once for each word
+func (index *sortedWordIndex) insertAll(words []string) {
+	sortedWords := make([]string, len(words))
+	copy(sortedWords, words)
+	sort.Strings(sortedWords)
+	newWords := sortedWords[:0]
+	for i, word := range sortedWords {
+		if (i == 0 || word != sortedWords[i-1]) && !index.contains(word) {
+			newWords = append(newWords, word)
+		}
+	}
+	if len(newWords) == 0 {
+		return
+	}
+
+	i := len(*index) - 1
+	*index = append(*index, newWords...)
+	for j, k := len(newWords)-1, len(*index)-1; j >= 0; k-- {
+		if i >= 0 && (*index)[i] > newWords[j] {
+			(*index)[k] = (*index)[i]
+			i--
+		} else {
+			(*index)[k] = newWords[j]
+			j--
+		}
+	}
+}
+
+//removeAll - removes words from the index in one pass
+func (index *sortedWordIndex) removeAll(words []string) {
+	if len(words) == 0 {
+		return
+	}
+	removedWords := make(map[string]bool, len(words))
+	for _, word := range words {
+		removedWords[word] = true
+	}
+	keptWords := (*index)[:0]
+	for _, word := range *index {
+		if !removedWords[word] {
+			keptWords = append(keptWords, word)
+		}
+	}
+	*index = keptWords
+}
+
+//contains - reports whether word is in the index
+func (index sortedWordIndex) contains(word string) bool {
+	i := sort.SearchStrings(index, word)
+	return i < len(index) && index[i] == word
+}
+
+//after - returns the position of the first word in the index which comes after word alphabetically
+func (index sortedWordIndex) after(word string) int {
+	i := sort.SearchStrings(index, word)
+	if i < len(index) && index[i] == word {
+		i++
+	}
+	return i
+}
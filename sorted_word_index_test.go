@@ -0,0 +1,77 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//benchmarkWords - returns numberOfWords distinct words, made of lowercase letters, in no particular order
+func benchmarkWords(numberOfWords int) []string {
+	words := make([]string, numberOfWords)
+	for i := range words {
+		//Spread consecutive words across the alphabet, so that they are not added in order
+		n := (i * 7919) % numberOfWords
+		word := []byte("w")
+		for ; n > 0; n /= 26 {
+			word = append(word, byte('a'+n%26))
+		}
+		words[i] = string(word)
+	}
+	return words
+}
+
+func TestSortedWordIndex(t *testing.T) {
+	t.Run("batch test", func(t *testing.T) {
+		index := sortedWordIndex{"b", "d", "f"}
+
+		//it should merge the words into the index, keeping it sorted and without repeating any word
+		index.insertAll([]string{"g", "a", "d", "c", "a"})
+		assert.EqualValues(t, sortedWordIndex{"a", "b", "c", "d", "f", "g"}, index)
+		index.insertAll([]string{})
+		assert.EqualValues(t, sortedWordIndex{"a", "b", "c", "d", "f", "g"}, index)
+
+		//it should remove the words from the index, ignoring words it does not hold
+		index.removeAll([]string{"a", "f", "z"})
+		assert.EqualValues(t, sortedWordIndex{"b", "c", "d", "g"}, index)
+	})
+	t.Run("dictionary test", func(t *testing.T) {
+		wordDictionary := NewWordDictionary("test")
+		words := benchmarkWords(550)
+
+		//it should hold the words of the dictionary in alphabetical order, however they were added
+		assert.NoError(t, wordDictionary.AddWords(words[:500]))
+		for _, word := range words[500:] {
+			assert.NoError(t, wordDictionary.AddWords([]string{word}))
+		}
+		assert.Len(t, wordDictionary.sortedWords, 550)
+		assert.True(t, sortedWordIndexIsSorted(wordDictionary.sortedWords))
+		_, err := wordDictionary.RollbackDictionary(1)
+		assert.NoError(t, err)
+		assert.Len(t, wordDictionary.sortedWords, 500)
+		assert.True(t, sortedWordIndexIsSorted(wordDictionary.sortedWords))
+	})
+}
+
+//sortedWordIndexIsSorted - reports whether each word of index comes after the previous word alphabetically
+func sortedWordIndexIsSorted(index sortedWordIndex) bool {
+	for i := 1; i < len(index); i++ {
+		if index[i-1] >= index[i] {
+			return false
+		}
+	}
+	return true
+}
+
+//BenchmarkWordDictionary_AddWords - adding a large batch of words should take time in proportion to the number of words,
+// rather than moving the sorted words once for each word added
+func BenchmarkWordDictionary_AddWords(b *testing.B) {
+	words := benchmarkWords(100000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		wordDictionary := NewWordDictionary("benchmark")
+		if err := wordDictionary.AddWords(words); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"sort"
+	"unicode"
+	"unicode/utf8"
+)
+
+//defaultMaxSuggestions - the number of corrections suggested per word when the caller does not specify a number
+const defaultMaxSuggestions = 5
+
+//defaultMaxEditDistance - how different a dictionary word may be from a misspelled word and still be suggested, when the caller does not specify
+const defaultMaxEditDistance = 2
+
+//CheckTextOptions - optional parameters for CheckText
+type CheckTextOptions struct {
+	//MaxSuggestions - the most corrections suggested per word
+	MaxSuggestions int
+	//MaxEditDistance - the most insertions, deletions, substitutions and transpositions a suggestion may be from the misspelled word
+	MaxEditDistance int
+}
+
+//SpellingSuggestion - a dictionary word suggested as a correction, along with its edit distance from the misspelled word
+type SpellingSuggestion struct {
+	Word     string
+	Distance int
+}
+
+//SpellingIssue - a word of a text which is not in the dictionary. Word is as written in the text, and the offsets locate it in the text
+type SpellingIssue struct {
+	Word        string
+	ByteStart   int
+	ByteEnd     int
+	RuneStart   int
+	RuneEnd     int
+	Suggestions []SpellingSuggestion
+}
+
+//CheckText - returns every word of text which is not in the dictionary, in the order they appear, with suggested corrections.
+// Suggestions are ranked by edit distance, then by how often the word has been searched for, then alphabetically. Numbers are not checked
+func (wordDictionary *WordDictionary) CheckText(text string, options CheckTextOptions) []SpellingIssue {
+	if options.MaxSuggestions <= 0 {
+		options.MaxSuggestions = defaultMaxSuggestions
+	}
+	if options.MaxEditDistance <= 0 {
+		options.MaxEditDistance = defaultMaxEditDistance
+	}
+
+	wordDictionary.mutex.Lock()
+	defer wordDictionary.mutex.Unlock()
+
+	issues := make([]SpellingIssue, 0)
+	suggestionsByWord := make(map[string][]SpellingSuggestion)
+	for _, token := range tokenizeText(text) {
+		if !containsLetter(token.word) || wordDictionary.isKnownWord(token.word) {
+			continue
+		}
+		suggestions, ok := suggestionsByWord[token.word]
+		if !ok {
+			suggestions = wordDictionary.suggestCorrections(token.word, options)
+			suggestionsByWord[token.word] = suggestions
+		}
+		issues = append(issues, SpellingIssue{
+			Word:        text[token.byteStart:token.byteEnd],
+			ByteStart:   token.byteStart,
+			ByteEnd:     token.byteEnd,
+			RuneStart:   token.runeStart,
+			RuneEnd:     token.runeEnd,
+			Suggestions: suggestions,
+		})
+	}
+	return issues
+}
+
+//isKnownWord - reports whether the lowercase word is a live, unblocked dictionary word. The caller must hold the mutex
+func (wordDictionary *WordDictionary) isKnownWord(lowercaseWord string) bool {
+	return wordDictionary.liveEntry(lowercaseWord) != nil && !wordDictionary.blocklist.IsBlocked(lowercaseWord)
+}
+
+//suggestCorrections - returns the dictionary words within the maximum edit distance of the lowercase word, best first. The caller must hold the mutex
+func (wordDictionary *WordDictionary) suggestCorrections(lowercaseWord string, options CheckTextOptions) []SpellingSuggestion {
+	wordRunes := []rune(lowercaseWord)
+	suggestions := make([]SpellingSuggestion, 0)
+	for _, dictionaryWord := range wordDictionary.sortedWords {
+		//Words whose lengths differ by more than the maximum distance cannot be close enough
+		lengthDifference := utf8.RuneCountInString(dictionaryWord) - len(wordRunes)
+		if lengthDifference > options.MaxEditDistance || -lengthDifference > options.MaxEditDistance {
+			continue
+		}
+		if !wordDictionary.isKnownWord(dictionaryWord) {
+			continue
+		}
+		distance := editDistance(wordRunes, []rune(dictionaryWord), options.MaxEditDistance)
+		if distance <= options.MaxEditDistance {
+			suggestions = append(suggestions, SpellingSuggestion{Word: dictionaryWord, Distance: distance})
+		}
+	}
+
+	popularity := func(word string) int64 {
+		if stat := wordDictionary.keyWordStatsMap[word]; stat != nil {
+			return stat.numberOfTimesSearched
+		}
+		return 0
+	}
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		if suggestions[i].Distance != suggestions[j].Distance {
+			return suggestions[i].Distance < suggestions[j].Distance
+		}
+		return popularity(suggestions[i].Word) > popularity(suggestions[j].Word)
+	})
+	if len(suggestions) > options.MaxSuggestions {
+		suggestions = suggestions[:options.MaxSuggestions]
+	}
+	return suggestions
+}
+
+//editDistance - returns the optimal string alignment distance between a and b: the number of insertions, deletions, substitutions and adjacent transpositions
+// needed to turn one into the other. Once the distance is certain to be over maxDistance, maxDistance+1 is returned
+func editDistance(a []rune, b []rune, maxDistance int) int {
+	//rows[0] is two rows back, rows[1] the previous row and rows[2] the current row
+	rows := [3][]int{make([]int, len(b)+1), make([]int, len(b)+1), make([]int, len(b)+1)}
+	for j := range rows[1] {
+		rows[1][j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		rows[2][0] = i
+		rowMinimum := i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			distance := minInt(rows[1][j]+1, rows[2][j-1]+1, rows[1][j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				distance = minInt(distance, rows[0][j-2]+1)
+			}
+			rows[2][j] = distance
+			rowMinimum = minInt(rowMinimum, distance)
+		}
+		if rowMinimum > maxDistance {
+			return maxDistance + 1
+		}
+		rows[0], rows[1], rows[2] = rows[1], rows[2], rows[0]
+	}
+	return rows[1][len(b)]
+}
+
+//minInt - returns the smallest of values
+func minInt(values ...int) int {
+	minimum := values[0]
+	for _, value := range values[1:] {
+		if value < minimum {
+			minimum = value
+		}
+	}
+	return minimum
+}
+
+//containsLetter - reports whether word contains a letter, so that numbers are not spell-checked
+func containsLetter(word string) bool {
+	for _, r := range word {
+		if unicode.IsLetter(r) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a        string
+		b        string
+		distance int
+	}{
+		{"kitten", "sitting", 3},
+		{"teh", "the", 1},
+		{"recieve", "receive", 1},
+		{"", "abc", 3},
+		{"café", "cafe", 1},
+	}
+	for _, test := range tests {
+		//it should count insertions, deletions, substitutions and transpositions
+		assert.EqualValues(t, test.distance, editDistance([]rune(test.a), []rune(test.b), 10), test.a)
+	}
+
+	//it should stop counting once the distance is over the maximum
+	assert.EqualValues(t, 2, editDistance([]rune("kitten"), []rune("sitting"), 1))
+}
+
+func TestWordDictionary_CheckText(t *testing.T) {
+	wordDictionary := NewWordDictionary("test")
+	wordDictionary.AddWords([]string{"the", "cat", "sat", "on", "mat", "hat", "receive", "café", "times"})
+	wordDictionary.SearchWord("sat")
+
+	//it should report each unknown word with its offsets and suggestions, ranking more frequently searched words first among equally close words
+	text := "Thé cät sat on teh mat, 42 times. Recieve"
+	issues := wordDictionary.CheckText(text, CheckTextOptions{})
+	assert.EqualValues(t, []SpellingIssue{
+		{Word: "Thé", ByteStart: 0, ByteEnd: 4, RuneStart: 0, RuneEnd: 3, Suggestions: []SpellingSuggestion{{"the", 1}}},
+		{Word: "cät", ByteStart: 5, ByteEnd: 9, RuneStart: 4, RuneEnd: 7, Suggestions: []SpellingSuggestion{{"cat", 1}, {"sat", 2}, {"hat", 2}, {"mat", 2}}},
+		{Word: "teh", ByteStart: 17, ByteEnd: 20, RuneStart: 15, RuneEnd: 18, Suggestions: []SpellingSuggestion{{"the", 1}}},
+		{Word: "Recieve", ByteStart: 36, ByteEnd: 43, RuneStart: 34, RuneEnd: 41, Suggestions: []SpellingSuggestion{{"receive", 1}}},
+	}, issues)
+
+	//it should limit the suggestions
+	issues = wordDictionary.CheckText("teh", CheckTextOptions{MaxSuggestions: 1, MaxEditDistance: 1})
+	assert.EqualValues(t, []SpellingSuggestion{{"the", 1}}, issues[0].Suggestions)
+
+	//it should not report correctly spelled text
+	assert.EqualValues(t, []SpellingIssue{}, wordDictionary.CheckText("The cat sat on the mat.", CheckTextOptions{}))
+}
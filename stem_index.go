@@ -0,0 +1,64 @@
+package main
+
+//stemIndex - the dictionary words grouped by their stem, so that a stemmed search only visits the words sharing the keyword's stem
+type stemIndex struct {
+	stemmer Stemmer
+	words   map[string]map[string]bool
+}
+
+//newStemIndex - creates an empty stemIndex which stems words with stemmer
+func newStemIndex(stemmer Stemmer) *stemIndex {
+	newStemIndex := new(stemIndex)
+	newStemIndex.stemmer = stemmer
+	newStemIndex.words = make(map[string]map[string]bool)
+	return newStemIndex
+}
+
+//add - adds word to the index
+func (index *stemIndex) add(word string) {
+	stem := index.stemmer.Stem(word)
+	if index.words[stem] == nil {
+		index.words[stem] = make(map[string]bool)
+	}
+	index.words[stem][word] = true
+}
+
+//remove - removes word from the index
+func (index *stemIndex) remove(word string) {
+	stem := index.stemmer.Stem(word)
+	delete(index.words[stem], word)
+	if len(index.words[stem]) == 0 {
+		delete(index.words, stem)
+	}
+}
+
+//entriesWithStems - returns the entries of dictionaryWords whose word has one of stems
+func (index *stemIndex) entriesWithStems(stems []string, dictionaryWords map[string]*wordEntry) map[string]*wordEntry {
+	entries := make(map[string]*wordEntry)
+	for _, stem := range stems {
+		for word := range index.words[stem] {
+			if entry := dictionaryWords[word]; entry != nil {
+				entries[word] = entry
+			}
+		}
+	}
+	return entries
+}
+
+//SetStemmingLanguage - sets the language the dictionary's words are stemmed in for stemmed searches, rebuilding the stem index
+func (wordDictionary *WordDictionary) SetStemmingLanguage(language string) error {
+	stemmer, err := StemmerForLanguage(language)
+	if err != nil {
+		return err
+	}
+
+	wordDictionary.mutex.Lock()
+	defer wordDictionary.mutex.Unlock()
+
+	index := newStemIndex(stemmer)
+	for word := range wordDictionary.dictionaryWords {
+		index.add(word)
+	}
+	wordDictionary.stemIndex = index
+	return nil
+}
@@ -0,0 +1,256 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+//DefaultStemmingLanguage - the language dictionaries stem words in until told otherwise
+const DefaultStemmingLanguage = "english"
+
+//ErrUnsupportedLanguage - returned when there is no stemmer for a language
+var ErrUnsupportedLanguage = errors.New("unsupported stemming language")
+
+//Stemmer - reduces a lowercase word to its stem, so that inflected forms of a word (run, runs, running) share the same stem
+type Stemmer interface {
+	Stem(word string) string
+}
+
+//stemmers - the stemmer for each supported language
+var stemmers = map[string]Stemmer{
+	"english": porterStemmer{},
+	"en":      porterStemmer{},
+}
+
+//StemmerForLanguage - returns the stemmer for language, e.g. "english" or "en"
+func StemmerForLanguage(language string) (Stemmer, error) {
+	stemmer := stemmers[strings.ToLower(strings.TrimSpace(language))]
+	if stemmer == nil {
+		return nil, errors.Wrap(ErrUnsupportedLanguage, fmt.Sprintf("no stemmer for %q", language))
+	}
+	return stemmer, nil
+}
+
+//
+//PORTER STEMMER
+//
+
+//porterStemmer - the Porter stemming algorithm for English, as described in "An algorithm for suffix stripping" (M.F. Porter, 1980).
+// Words which are not made entirely of the letters a-z are returned unchanged
+type porterStemmer struct{}
+
+//porterSuffixRule - replaces suffix with replacement
+type porterSuffixRule struct {
+	suffix      string
+	replacement string
+}
+
+//porterStep2Rules - the step 2 rules, applied when the measure of the stem is greater than 0. Longer suffixes come before the shorter suffixes they end with
+var porterStep2Rules = []porterSuffixRule{
+	{"ational", "ate"}, {"tional", "tion"}, {"enci", "ence"}, {"anci", "ance"}, {"izer", "ize"}, {"abli", "able"},
+	{"alli", "al"}, {"entli", "ent"}, {"eli", "e"}, {"ousli", "ous"}, {"ization", "ize"}, {"ation", "ate"},
+	{"ator", "ate"}, {"alism", "al"}, {"iveness", "ive"}, {"fulness", "ful"}, {"ousness", "ous"}, {"aliti", "al"},
+	{"iviti", "ive"}, {"biliti", "ble"},
+}
+
+//porterStep3Rules - the step 3 rules, applied when the measure of the stem is greater than 0
+var porterStep3Rules = []porterSuffixRule{
+	{"icate", "ic"}, {"ative", ""}, {"alize", "al"}, {"iciti", "ic"}, {"ical", "ic"}, {"ful", ""}, {"ness", ""},
+}
+
+//porterStep4Suffixes - the step 4 suffixes, removed when the measure of the stem is greater than 1
+var porterStep4Suffixes = []string{
+	"al", "ance", "ence", "er", "ic", "able", "ible", "ant", "ement", "ment", "ent", "ion", "ou", "ism", "ate", "iti", "ous", "ive", "ize",
+}
+
+//Stem - returns the Porter stem of the lowercase word
+func (porterStemmer) Stem(word string) string {
+	if len(word) <= 2 {
+		return word
+	}
+	for i := 0; i < len(word); i++ {
+		if word[i] < 'a' || word[i] > 'z' {
+			return word
+		}
+	}
+
+	b := []byte(word)
+	b = porterStep1a(b)
+	b = porterStep1b(b)
+	b = porterStep1c(b)
+	b = porterApplyRules(b, porterStep2Rules)
+	b = porterApplyRules(b, porterStep3Rules)
+	b = porterStep4(b)
+	b = porterStep5(b)
+	return string(b)
+}
+
+//porterConsonant - reports whether b[i] is a consonant. y is a consonant unless it follows a consonant
+func porterConsonant(b []byte, i int) bool {
+	switch b[i] {
+	case 'a', 'e', 'i', 'o', 'u':
+		return false
+	case 'y':
+		return i == 0 || !porterConsonant(b, i-1)
+	}
+	return true
+}
+
+//porterMeasure - returns m, the number of vowel-consonant sequences in b when it is written [C](VC){m}[V]
+func porterMeasure(b []byte) int {
+	m, i := 0, 0
+	for i < len(b) && porterConsonant(b, i) {
+		i++
+	}
+	for i < len(b) {
+		for i < len(b) && !porterConsonant(b, i) {
+			i++
+		}
+		if i == len(b) {
+			break
+		}
+		for i < len(b) && porterConsonant(b, i) {
+			i++
+		}
+		m++
+	}
+	return m
+}
+
+//porterContainsVowel - reports whether b contains a vowel
+func porterContainsVowel(b []byte) bool {
+	for i := range b {
+		if !porterConsonant(b, i) {
+			return true
+		}
+	}
+	return false
+}
+
+//porterEndsDoubleConsonant - reports whether b ends with two of the same consonant
+func porterEndsDoubleConsonant(b []byte) bool {
+	n := len(b)
+	return n >= 2 && b[n-1] == b[n-2] && porterConsonant(b, n-1)
+}
+
+//porterEndsCVC - reports whether b ends consonant-vowel-consonant, where the last consonant is not w, x or y
+func porterEndsCVC(b []byte) bool {
+	n := len(b)
+	if n < 3 || !porterConsonant(b, n-3) || porterConsonant(b, n-2) || !porterConsonant(b, n-1) {
+		return false
+	}
+	return b[n-1] != 'w' && b[n-1] != 'x' && b[n-1] != 'y'
+}
+
+//porterHasSuffix - reports whether b ends with suffix
+func porterHasSuffix(b []byte, suffix string) bool {
+	return len(b) >= len(suffix) && string(b[len(b)-len(suffix):]) == suffix
+}
+
+//porterReplace - replaces the suffix at the end of b with replacement
+func porterReplace(b []byte, suffix string, replacement string) []byte {
+	return append(b[:len(b)-len(suffix)], replacement...)
+}
+
+//porterStep1a - removes plurals: sses -> ss, ies -> i, ss -> ss, s -> ""
+func porterStep1a(b []byte) []byte {
+	switch {
+	case porterHasSuffix(b, "sses"):
+		return porterReplace(b, "sses", "ss")
+	case porterHasSuffix(b, "ies"):
+		return porterReplace(b, "ies", "i")
+	case porterHasSuffix(b, "ss"):
+		return b
+	case porterHasSuffix(b, "s"):
+		return porterReplace(b, "s", "")
+	}
+	return b
+}
+
+//porterStep1b - removes -eed, -ed and -ing, tidying up the stem which is left
+func porterStep1b(b []byte) []byte {
+	if porterHasSuffix(b, "eed") {
+		if porterMeasure(b[:len(b)-3]) > 0 {
+			return porterReplace(b, "eed", "ee")
+		}
+		return b
+	}
+
+	var stem []byte
+	switch {
+	case porterHasSuffix(b, "ed") && porterContainsVowel(b[:len(b)-2]):
+		stem = b[:len(b)-2]
+	case porterHasSuffix(b, "ing") && porterContainsVowel(b[:len(b)-3]):
+		stem = b[:len(b)-3]
+	default:
+		return b
+	}
+
+	switch {
+	case porterHasSuffix(stem, "at"), porterHasSuffix(stem, "bl"), porterHasSuffix(stem, "iz"):
+		return append(stem, 'e')
+	case porterEndsDoubleConsonant(stem):
+		last := stem[len(stem)-1]
+		if last != 'l' && last != 's' && last != 'z' {
+			return stem[:len(stem)-1]
+		}
+	case porterMeasure(stem) == 1 && porterEndsCVC(stem):
+		return append(stem, 'e')
+	}
+	return stem
+}
+
+//porterStep1c - turns a final y into i when the stem contains a vowel
+func porterStep1c(b []byte) []byte {
+	if porterHasSuffix(b, "y") && porterContainsVowel(b[:len(b)-1]) {
+		b[len(b)-1] = 'i'
+	}
+	return b
+}
+
+//porterApplyRules - applies the first rule whose suffix b ends with, if the measure of the stem is greater than 0
+func porterApplyRules(b []byte, rules []porterSuffixRule) []byte {
+	for _, rule := range rules {
+		if porterHasSuffix(b, rule.suffix) {
+			if porterMeasure(b[:len(b)-len(rule.suffix)]) > 0 {
+				return porterReplace(b, rule.suffix, rule.replacement)
+			}
+			return b
+		}
+	}
+	return b
+}
+
+//porterStep4 - removes the first suffix b ends with, if the measure of the stem is greater than 1. -ion is only removed after s or t
+func porterStep4(b []byte) []byte {
+	for _, suffix := range porterStep4Suffixes {
+		if porterHasSuffix(b, suffix) {
+			stem := b[:len(b)-len(suffix)]
+			if porterMeasure(stem) <= 1 {
+				return b
+			}
+			if suffix == "ion" && !porterHasSuffix(stem, "s") && !porterHasSuffix(stem, "t") {
+				return b
+			}
+			return stem
+		}
+	}
+	return b
+}
+
+//porterStep5 - removes a final e, and reduces a final double l, where the stem is long enough
+func porterStep5(b []byte) []byte {
+	if porterHasSuffix(b, "e") {
+		stem := b[:len(b)-1]
+		m := porterMeasure(stem)
+		if m > 1 || (m == 1 && !porterEndsCVC(stem)) {
+			b = stem
+		}
+	}
+	if porterMeasure(b) > 1 && porterEndsDoubleConsonant(b) && porterHasSuffix(b, "l") {
+		b = b[:len(b)-1]
+	}
+	return b
+}
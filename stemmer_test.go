@@ -0,0 +1,82 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPorterStemmer(t *testing.T) {
+	tests := map[string]string{
+		"caresses":       "caress",
+		"ponies":         "poni",
+		"cats":           "cat",
+		"feed":           "feed",
+		"agreed":         "agre",
+		"plastered":      "plaster",
+		"motoring":       "motor",
+		"sing":           "sing",
+		"conflated":      "conflat",
+		"troubled":       "troubl",
+		"sized":          "size",
+		"hopping":        "hop",
+		"falling":        "fall",
+		"hissing":        "hiss",
+		"filing":         "file",
+		"happy":          "happi",
+		"relational":     "relat",
+		"conditional":    "condit",
+		"generalization": "gener",
+		"adjustment":     "adjust",
+		"adoption":       "adopt",
+		"controll":       "control",
+		"running":        "run",
+		"runs":           "run",
+		"run":            "run",
+		"café":           "café",
+	}
+	stemmer, err := StemmerForLanguage("English")
+	assert.NoError(t, err)
+	for word, stem := range tests {
+		//it should reduce the word to its Porter stem
+		assert.EqualValues(t, stem, stemmer.Stem(word), word)
+	}
+
+	//it should refuse languages without a stemmer
+	_, err = StemmerForLanguage("klingon")
+	assert.True(t, errors.Cause(err) == ErrUnsupportedLanguage)
+}
+
+func TestStemmedSearch(t *testing.T) {
+	wordDictionary := NewWordDictionary("test")
+	wordDictionary.AddWords([]string{"run", "runs", "running", "runner", "rune", "brunch"})
+
+	//it should return the original forms of the words sharing the keyword's stem
+	results, err := wordDictionary.Search("Running", SearchOptions{Stemming: true})
+	assert.NoError(t, err)
+	assert.EqualValues(t, []string{"run", "running", "runs"}, matchWords(results.Matches))
+
+	//it should keep the stem index up to date as words are added and removed
+	wordDictionary.AddWords([]string{"runnings"})
+	versionBeforeRemoval := wordDictionary.Version()
+	wordDictionary.UpsertWords([]WordEntry{{Word: "runs", ExpiresAt: time.Now().Add(-time.Minute)}})
+	wordDictionary.RemoveExpiredWords()
+	results, _ = wordDictionary.Search("run", SearchOptions{Stemming: true})
+	assert.EqualValues(t, []string{"run", "running", "runnings"}, matchWords(results.Matches))
+
+	//it should stem earlier versions of the dictionary too
+	results, _ = wordDictionary.Search("run", SearchOptions{Stemming: true, Version: versionBeforeRemoval})
+	assert.EqualValues(t, []string{"run", "running", "runnings", "runs"}, matchWords(results.Matches))
+
+	//it should highlight the stem within each match
+	results, _ = wordDictionary.Search("runs", SearchOptions{Stemming: true, IncludeHighlights: true})
+	assert.EqualValues(t, []HighlightSpan{{0, 3}}, results.Matches[1].Highlights)
+
+	//it should refuse to switch to a language without a stemmer
+	assert.Error(t, wordDictionary.SetStemmingLanguage("klingon"))
+	assert.NoError(t, wordDictionary.SetStemmingLanguage("en"))
+	results, _ = wordDictionary.Search("runner", SearchOptions{Stemming: true})
+	assert.EqualValues(t, []string{"runner"}, matchWords(results.Matches))
+}
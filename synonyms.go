@@ -0,0 +1,202 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+//ErrSynonymGroupNotFound - returned when removing a synonym group which does not exist
+var ErrSynonymGroupNotFound = errors.New("synonym group not found")
+
+//SynonymGroup - a set of words which mean the same thing, e.g. {automobile, car, vehicle}
+type SynonymGroup struct {
+	ID    int64
+	Words []string
+}
+
+//SynonymGroups - the managed synonym groups used to expand searches. A word may belong to more than one group
+type SynonymGroups struct {
+	mutex        sync.RWMutex
+	groups       map[int64][]string
+	groupsByWord map[string][]int64
+	nextID       int64
+}
+
+//NewSynonymGroups - creates a new, empty SynonymGroups
+func NewSynonymGroups() *SynonymGroups {
+	newSynonymGroups := new(SynonymGroups)
+	newSynonymGroups.groups = make(map[int64][]string)
+	newSynonymGroups.groupsByWord = make(map[string][]int64)
+	newSynonymGroups.nextID = 1
+	return newSynonymGroups
+}
+
+//AddGroup - adds a group of synonyms, returning its id. The group must hold at least two different words
+func (synonymGroups *SynonymGroups) AddGroup(words []string) (id int64, err error) {
+	seenWords := make(map[string]bool, len(words))
+	group := make([]string, 0, len(words))
+	for i := range words {
+		word := normalizeWord(strings.TrimSpace(words[i]))
+		if word != "" && !seenWords[word] {
+			seenWords[word] = true
+			group = append(group, word)
+		}
+	}
+	if len(group) < 2 {
+		return 0, errors.New(fmt.Sprintf("a synonym group needs at least two different words, got %q", words))
+	}
+	sort.Strings(group)
+
+	synonymGroups.mutex.Lock()
+	defer synonymGroups.mutex.Unlock()
+
+	id = synonymGroups.nextID
+	synonymGroups.nextID++
+	synonymGroups.groups[id] = group
+	for _, word := range group {
+		synonymGroups.groupsByWord[word] = append(synonymGroups.groupsByWord[word], id)
+	}
+	return id, nil
+}
+
+//RemoveGroup - removes the group with the given id
+func (synonymGroups *SynonymGroups) RemoveGroup(id int64) error {
+	synonymGroups.mutex.Lock()
+	defer synonymGroups.mutex.Unlock()
+
+	group := synonymGroups.groups[id]
+	if group == nil {
+		return errors.Wrap(ErrSynonymGroupNotFound, fmt.Sprintf("%d", id))
+	}
+	delete(synonymGroups.groups, id)
+	for _, word := range group {
+		ids := synonymGroups.groupsByWord[word]
+		for i := range ids {
+			if ids[i] == id {
+				ids = append(ids[:i:i], ids[i+1:]...)
+				break
+			}
+		}
+		if len(ids) == 0 {
+			delete(synonymGroups.groupsByWord, word)
+		} else {
+			synonymGroups.groupsByWord[word] = ids
+		}
+	}
+	return nil
+}
+
+//ListGroups - returns the groups in the order they were added
+func (synonymGroups *SynonymGroups) ListGroups() []SynonymGroup {
+	synonymGroups.mutex.RLock()
+	defer synonymGroups.mutex.RUnlock()
+
+	groups := make([]SynonymGroup, 0, len(synonymGroups.groups))
+	for id, words := range synonymGroups.groups {
+		groups = append(groups, SynonymGroup{ID: id, Words: append([]string(nil), words...)})
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].ID < groups[j].ID })
+	return groups
+}
+
+//Synonyms - returns the synonyms of the lowercase word from every group it belongs to, in alphabetical order and excluding the word itself.
+// A nil SynonymGroups has no synonyms
+func (synonymGroups *SynonymGroups) Synonyms(lowercaseWord string) []string {
+	if synonymGroups == nil {
+		return nil
+	}
+
+	synonymGroups.mutex.RLock()
+	defer synonymGroups.mutex.RUnlock()
+
+	seenWords := map[string]bool{lowercaseWord: true}
+	synonyms := make([]string, 0)
+	for _, id := range synonymGroups.groupsByWord[lowercaseWord] {
+		for _, word := range synonymGroups.groups[id] {
+			if !seenWords[word] {
+				seenWords[word] = true
+				synonyms = append(synonyms, word)
+			}
+		}
+	}
+	sort.Strings(synonyms)
+	return synonyms
+}
+
+//StemmedSynonyms - returns the synonyms, from every group holding a word which shares the stem of the lowercase word, in alphabetical order.
+// Words sharing the stem are excluded, as a stemmed search matches them already. A nil SynonymGroups has no synonyms
+func (synonymGroups *SynonymGroups) StemmedSynonyms(lowercaseWord string, stemmer Stemmer) []string {
+	if synonymGroups == nil {
+		return nil
+	}
+	stem := stemmer.Stem(lowercaseWord)
+
+	synonymGroups.mutex.RLock()
+	defer synonymGroups.mutex.RUnlock()
+
+	seenWords := make(map[string]bool)
+	synonyms := make([]string, 0)
+	for groupWord, ids := range synonymGroups.groupsByWord {
+		if stemmer.Stem(groupWord) != stem {
+			continue
+		}
+		for _, id := range ids {
+			for _, word := range synonymGroups.groups[id] {
+				if !seenWords[word] && stemmer.Stem(word) != stem {
+					seenWords[word] = true
+					synonyms = append(synonyms, word)
+				}
+			}
+		}
+	}
+	sort.Strings(synonyms)
+	return synonyms
+}
+
+//SetSynonyms - sets the synonym groups used to expand the dictionary's searches
+func (wordDictionary *WordDictionary) SetSynonyms(synonymGroups *SynonymGroups) {
+	wordDictionary.mutex.Lock()
+	defer wordDictionary.mutex.Unlock()
+
+	wordDictionary.synonyms = synonymGroups
+}
+
+//ParseSynonymFile - reads synonym groups from a file with one group per line, its words separated by commas.
+// Blank lines and lines starting with # are ignored
+func ParseSynonymFile(reader io.Reader) (groups [][]string, err error) {
+	groups = make([][]string, 0)
+	scanner := bufio.NewScanner(reader)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		words := strings.Split(line, ",")
+		if _, err := NewSynonymGroups().AddGroup(words); err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("line %d", lineNumber))
+		}
+		groups = append(groups, words)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to read synonyms")
+	}
+	return groups, nil
+}
+
+//LoadSynonymFile - reads the synonym groups from the file at synonymsPath, see ParseSynonymFile
+func LoadSynonymFile(synonymsPath string) (groups [][]string, err error) {
+	file, err := os.Open(synonymsPath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return ParseSynonymFile(file)
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSynonymGroups(t *testing.T) {
+	t.Run("management test", func(t *testing.T) {
+		synonymGroups := NewSynonymGroups()
+		carID, err := synonymGroups.AddGroup([]string{"Car", "automobile", "vehicle", "car"})
+		assert.NoError(t, err)
+		synonymGroups.AddGroup([]string{"vehicle", "conveyance"})
+
+		//it should return the synonyms from every group the word belongs to, without the word itself
+		assert.EqualValues(t, []string{"automobile", "car", "conveyance"}, synonymGroups.Synonyms("vehicle"))
+		assert.EqualValues(t, []string{"automobile", "vehicle"}, synonymGroups.Synonyms("car"))
+
+		//it should refuse groups of fewer than two words
+		_, err = synonymGroups.AddGroup([]string{"car", "CAR", " "})
+		assert.Error(t, err)
+
+		//it should remove groups
+		assert.NoError(t, synonymGroups.RemoveGroup(carID))
+		assert.EqualValues(t, []string{"conveyance"}, synonymGroups.Synonyms("vehicle"))
+		assert.EqualValues(t, []string{}, synonymGroups.Synonyms("car"))
+		assert.EqualValues(t, []SynonymGroup{{ID: 2, Words: []string{"conveyance", "vehicle"}}}, synonymGroups.ListGroups())
+		assert.True(t, errors.Cause(synonymGroups.RemoveGroup(carID)) == ErrSynonymGroupNotFound)
+	})
+	t.Run("expansion test", func(t *testing.T) {
+		wordSearchService := NewWordSearchService()
+		wordSearchService.Synonyms().AddGroup([]string{"car", "automobile", "vehicle"})
+		dictionary, _ := wordSearchService.CreateDictionary("garage")
+		dictionary.AddWords([]string{"carpet", "automobiles", "vehicle", "motorbike"})
+
+		//it should only match the keyword when not expanding
+		results, _ := dictionary.Search("car", SearchOptions{})
+		assert.EqualValues(t, []string{"carpet"}, matchWords(results.Matches))
+
+		//it should also match the synonyms, saying which synonym each expanded match came from
+		results, _ = dictionary.Search("car", SearchOptions{ExpandSynonyms: true, IncludeHighlights: true})
+		assert.EqualValues(t, []SearchMatch{
+			{Word: "automobiles", ExpandedFrom: "automobile", Highlights: []HighlightSpan{{0, 10}}},
+			{Word: "carpet", Highlights: []HighlightSpan{{0, 3}}},
+			{Word: "vehicle", ExpandedFrom: "vehicle", Highlights: []HighlightSpan{{0, 7}}},
+		}, results.Matches)
+
+		//it should expand stemmed searches by the stems of the synonyms, looking the synonyms up by the keyword's stem
+		results, _ = dictionary.Search("cars", SearchOptions{ExpandSynonyms: true, Stemming: true})
+		assert.EqualValues(t, []string{"automobiles", "vehicle"}, matchWords(results.Matches))
+		results, _ = dictionary.Search("car", SearchOptions{ExpandSynonyms: true, Stemming: true})
+		assert.EqualValues(t, []string{"automobiles", "vehicle"}, matchWords(results.Matches))
+		results, _ = dictionary.Search("vehicles", SearchOptions{ExpandSynonyms: true, Stemming: true})
+		assert.EqualValues(t, []string{"automobiles", "vehicle"}, matchWords(results.Matches))
+	})
+	t.Run("synonym file test", func(t *testing.T) {
+		//it should read one group per line, skipping blank lines and comments
+		groups, err := ParseSynonymFile(strings.NewReader("# vehicles\ncar, automobile,vehicle\n\nbig,large\n"))
+		assert.NoError(t, err)
+		assert.EqualValues(t, [][]string{{"car", " automobile", "vehicle"}, {"big", "large"}}, groups)
+
+		//it should report the line of an invalid group
+		_, err = ParseSynonymFile(strings.NewReader("car,automobile\nbig\n"))
+		assert.Contains(t, err.Error(), "line 2")
+
+		//it should start each new tenant with the groups
+		tenantRegistry := NewTenantRegistry(TenantQuota{}, nil)
+		assert.NoError(t, tenantRegistry.SetSynonymGroups(groups))
+		acme, _ := tenantRegistry.AddTenant(TenantConfig{ID: "acme"})
+		assert.EqualValues(t, []string{"big"}, acme.WordSearchService().Synonyms().Synonyms("large"))
+		assert.Error(t, tenantRegistry.SetSynonymGroups([][]string{{"lonely"}}))
+	})
+}
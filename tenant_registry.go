@@ -0,0 +1,230 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+//DefaultTenantID - the tenant used for requests which do not identify a tenant
+const DefaultTenantID = "default"
+
+//ErrTenantNotFound - returned when a request is made for a tenant which is not hosted on this instance, or which its API key does not belong to
+var ErrTenantNotFound = errors.New("tenant not found")
+
+//ErrInvalidAPIKey - returned when a request carries an API key which does not belong to any tenant
+var ErrInvalidAPIKey = errors.New("invalid API key")
+
+//ErrAPIKeyRequired - returned when a request for a tenant which has API keys does not carry one
+var ErrAPIKeyRequired = errors.New("API key required")
+
+//TenantConfig - a tenant hosted on this instance
+type TenantConfig struct {
+	ID string `json:"id"`
+	//APIKeySHA256 - the hex SHA-256 digests of the API keys the tenant's clients authenticate with.
+	// A tenant without API keys serves any request which names it
+	APIKeySHA256 []string `json:"apiKeySha256"`
+	//AdminAPIKeySHA256 - the hex SHA-256 digests of API keys which authenticate as the tenant's administrators, who may also make
+	// requests affecting any of the tenant's users, such as erasing a user's history. They are API keys of the tenant as well
+	AdminAPIKeySHA256 []string `json:"adminApiKeySha256"`
+	//Quota - when set, the limits applied to the tenant in place of the registry's quota
+	Quota *TenantQuota `json:"quota,omitempty"`
+}
+
+//TenantQuota - the limits applied to a tenant. A value of 0 means the limit is not enforced
+type TenantQuota struct {
+	MaxDictionaryWords int `json:"maxDictionaryWords"`
+	//MaxTenantWords - the most words the tenant may hold across all of its dictionaries and documents, where each term of a document counts as a word
+	MaxTenantWords int `json:"maxTenantWords"`
+	//MaxDictionaries - the most dictionaries the tenant may hold, including the default dictionary
+	MaxDictionaries   int     `json:"maxDictionaries"`
+	RequestsPerSecond float64 `json:"requestsPerSecond"`
+	RequestBurst      int     `json:"requestBurst"`
+}
+
+//Tenant - a customer hosted on this instance, with its own dictionaries, keyword statistics and request rate limit
+type Tenant struct {
+	id                string
+	requiresAPIKey    bool
+	adminAPIKeys      map[[sha256.Size]byte]bool
+	wordSearchService *WordSearchService
+	rateLimiter       *rateLimiter
+}
+
+//ID - returns the tenant's id
+func (tenant *Tenant) ID() string {
+	return tenant.id
+}
+
+//WordSearchService - returns the tenant's own WordSearchService
+func (tenant *Tenant) WordSearchService() *WordSearchService {
+	return tenant.wordSearchService
+}
+
+//IsAdminAPIKey - reports whether apiKey is one of the tenant's admin API keys
+func (tenant *Tenant) IsAdminAPIKey(apiKey string) bool {
+	return apiKey != "" && tenant.adminAPIKeys[sha256.Sum256([]byte(apiKey))]
+}
+
+//AllowRequest - reports whether the tenant is within its request rate limit, consuming one request if it is
+func (tenant *Tenant) AllowRequest() bool {
+	return tenant.rateLimiter.Allow()
+}
+
+//TenantRegistry - creates and holds the tenants of this instance, keeping each tenant's data isolated from the others
+type TenantRegistry struct {
+	mutex                   sync.Mutex
+	quota                   TenantQuota
+	wordValidator           *WordValidator
+	synonymGroups           [][]string
+	autocompleteBlendWeight float64
+	tenants                 map[string]*Tenant
+	//apiKeys - the id of the tenant each API key belongs to, by the SHA-256 digest of the key
+	apiKeys map[[sha256.Size]byte]string
+	now     func() time.Time
+}
+
+//NewTenantRegistry - creates a new TenantRegistry which applies quota and wordValidator to each of its tenants. A tenant's own quota replaces quota
+func NewTenantRegistry(quota TenantQuota, wordValidator *WordValidator) *TenantRegistry {
+	newTenantRegistry := new(TenantRegistry)
+	newTenantRegistry.quota = quota
+	newTenantRegistry.wordValidator = wordValidator
+	newTenantRegistry.autocompleteBlendWeight = DefaultAutocompleteBlendWeight
+	newTenantRegistry.tenants = make(map[string]*Tenant)
+	newTenantRegistry.apiKeys = make(map[[sha256.Size]byte]string)
+	newTenantRegistry.now = time.Now
+	return newTenantRegistry
+}
+
+//SetSynonymGroups - sets the synonym groups each tenant starts with. Only tenants added afterwards are affected, the groups can then be managed per tenant
+func (tenantRegistry *TenantRegistry) SetSynonymGroups(synonymGroups [][]string) error {
+	for _, words := range synonymGroups {
+		if _, err := NewSynonymGroups().AddGroup(words); err != nil {
+			return err
+		}
+	}
+
+	tenantRegistry.mutex.Lock()
+	defer tenantRegistry.mutex.Unlock()
+
+	tenantRegistry.synonymGroups = synonymGroups
+	return nil
+}
+
+//AddTenant - hosts the tenant described by tenantConfig, with its own dictionaries and request rate limit
+func (tenantRegistry *TenantRegistry) AddTenant(tenantConfig TenantConfig) (*Tenant, error) {
+	if tenantConfig.ID == "" {
+		return nil, errors.New("a tenant needs an id")
+	}
+	digests, err := apiKeyDigests(tenantConfig.ID, "API key", tenantConfig.APIKeySHA256)
+	if err != nil {
+		return nil, err
+	}
+	adminDigests, err := apiKeyDigests(tenantConfig.ID, "admin API key", tenantConfig.AdminAPIKeySHA256)
+	if err != nil {
+		return nil, err
+	}
+	digests = append(digests, adminDigests...)
+
+	tenantRegistry.mutex.Lock()
+	defer tenantRegistry.mutex.Unlock()
+
+	if tenantRegistry.tenants[tenantConfig.ID] != nil {
+		return nil, errors.New(fmt.Sprintf("tenant %s already exists", tenantConfig.ID))
+	}
+	for _, digest := range digests {
+		if owner, exists := tenantRegistry.apiKeys[digest]; exists {
+			return nil, errors.New(fmt.Sprintf("tenant %s: an API key is already used by tenant %s", tenantConfig.ID, owner))
+		}
+	}
+
+	quota := tenantRegistry.quota
+	if tenantConfig.Quota != nil {
+		quota = *tenantConfig.Quota
+	}
+
+	tenant := new(Tenant)
+	tenant.id = tenantConfig.ID
+	tenant.requiresAPIKey = len(digests) > 0
+	tenant.adminAPIKeys = make(map[[sha256.Size]byte]bool, len(adminDigests))
+	for _, digest := range adminDigests {
+		tenant.adminAPIKeys[digest] = true
+	}
+	tenant.wordSearchService = NewWordSearchService()
+	tenant.wordSearchService.SetWordLimit(quota.MaxDictionaryWords)
+	tenant.wordSearchService.SetTenantWordLimit(quota.MaxTenantWords)
+	tenant.wordSearchService.SetDictionaryLimit(quota.MaxDictionaries)
+	tenant.wordSearchService.SetWordValidator(tenantRegistry.wordValidator)
+	//The weight was checked by SetAutocompleteBlendWeight
+	tenant.wordSearchService.SetAutocompleteBlendWeight(tenantRegistry.autocompleteBlendWeight)
+	for _, words := range tenantRegistry.synonymGroups {
+		//The groups were checked by SetSynonymGroups
+		tenant.wordSearchService.Synonyms().AddGroup(words)
+	}
+	tenant.rateLimiter = newRateLimiter(quota.RequestsPerSecond, quota.RequestBurst, tenantRegistry.now)
+	tenantRegistry.tenants[tenant.id] = tenant
+	for _, digest := range digests {
+		tenantRegistry.apiKeys[digest] = tenant.id
+	}
+	return tenant, nil
+}
+
+//apiKeyDigests - decodes the hex SHA-256 digests of the tenant's API keys, described by kind in errors
+func apiKeyDigests(tenantID string, kind string, digestHexes []string) ([][sha256.Size]byte, error) {
+	digests := make([][sha256.Size]byte, len(digestHexes))
+	for i, digestHex := range digestHexes {
+		digest, err := hex.DecodeString(digestHex)
+		if err != nil || len(digest) != sha256.Size {
+			return nil, errors.New(fmt.Sprintf("tenant %s: %s digest %d is not a hex SHA-256 digest", tenantID, kind, i+1))
+		}
+		copy(digests[i][:], digest)
+	}
+	return digests, nil
+}
+
+//Tenant - returns the tenant with the given id. An empty id refers to the default tenant
+func (tenantRegistry *TenantRegistry) Tenant(id string) (*Tenant, error) {
+	if id == "" {
+		id = DefaultTenantID
+	}
+
+	tenantRegistry.mutex.Lock()
+	defer tenantRegistry.mutex.Unlock()
+
+	tenant := tenantRegistry.tenants[id]
+	if tenant == nil {
+		return nil, errors.Wrap(ErrTenantNotFound, id)
+	}
+	return tenant, nil
+}
+
+//Authenticate - returns the tenant a request is made for. A request with an API key is made for the tenant the key belongs to,
+// and may only name that tenant. A request without one is made for the tenant it names, which must not have API keys.
+// Tenants are never created by a request, so an unknown tenant id is refused rather than given fresh quotas
+func (tenantRegistry *TenantRegistry) Authenticate(id string, apiKey string) (*Tenant, error) {
+	if apiKey == "" {
+		tenant, err := tenantRegistry.Tenant(id)
+		if err != nil {
+			return nil, err
+		}
+		if tenant.requiresAPIKey {
+			return nil, errors.Wrap(ErrAPIKeyRequired, tenant.id)
+		}
+		return tenant, nil
+	}
+
+	tenantRegistry.mutex.Lock()
+	owner, exists := tenantRegistry.apiKeys[sha256.Sum256([]byte(apiKey))]
+	tenantRegistry.mutex.Unlock()
+	if !exists {
+		return nil, ErrInvalidAPIKey
+	}
+	if id != "" && id != owner {
+		return nil, errors.Wrap(ErrTenantNotFound, id)
+	}
+	return tenantRegistry.Tenant(owner)
+}
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTenantRegistry(t *testing.T) {
+	t.Run("isolation test", func(t *testing.T) {
+		tenantRegistry := NewTenantRegistry(TenantQuota{}, nil)
+		acme := mustAddTenant(t, tenantRegistry, TenantConfig{ID: "acme"}).WordSearchService()
+		globex := mustAddTenant(t, tenantRegistry, TenantConfig{ID: "globex"}).WordSearchService()
+		mustAddTenant(t, tenantRegistry, TenantConfig{ID: DefaultTenantID})
+
+		//it should return the same tenant for the same id
+		tenant, err := tenantRegistry.Tenant("acme")
+		assert.NoError(t, err)
+		assert.True(t, acme == tenant.WordSearchService())
+
+		//it should treat an empty id as the default tenant
+		tenant, err = tenantRegistry.Tenant("")
+		assert.NoError(t, err)
+		assert.EqualValues(t, DefaultTenantID, tenant.ID())
+
+		//it should not create tenants which were not added
+		_, err = tenantRegistry.Tenant("initech")
+		assert.Equal(t, ErrTenantNotFound, errors.Cause(err))
+		_, err = tenantRegistry.AddTenant(TenantConfig{ID: "acme"})
+		assert.Error(t, err)
+
+		//it should keep each tenant's words separate
+		acme.AddWords([]string{"rocket"})
+		assert.EqualValues(t, []string{"rocket"}, acme.SearchWord("rock"))
+		assert.EqualValues(t, []string{}, globex.SearchWord("rock"))
+
+		//it should keep each tenant's keyword statistics separate
+		assert.EqualValues(t, []string{"rock"}, acme.Top5SearchKeyWords())
+		assert.EqualValues(t, []string{"rock"}, globex.Top5SearchKeyWords())
+		globex.SearchWord("hello")
+		assert.EqualValues(t, []string{"rock"}, acme.Top5SearchKeyWords())
+	})
+	t.Run("dictionary size quota", func(t *testing.T) {
+		tenantRegistry := NewTenantRegistry(TenantQuota{MaxDictionaryWords: 9}, nil)
+		wordSearchService := mustAddTenant(t, tenantRegistry, TenantConfig{ID: "acme"}).WordSearchService()
+
+		//it should allow words to be added up to the limit (the default dictionary starts with 7 words)
+		assert.NoError(t, wordSearchService.AddWords([]string{"one", "two"}))
+
+		//it should refuse words beyond the limit, without adding any of them
+		assert.Error(t, wordSearchService.AddWords([]string{"three"}))
+		assert.EqualValues(t, []string{}, wordSearchService.SearchWord("three"))
+
+		//it should apply the limit to dictionaries created later
+		french, _ := wordSearchService.CreateDictionary("french")
+		assert.Error(t, french.AddWords([]string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"}))
+	})
+	t.Run("tenant size quota", func(t *testing.T) {
+		tenantRegistry := NewTenantRegistry(TenantQuota{MaxTenantWords: 12, MaxDictionaries: 2}, nil)
+		wordSearchService := mustAddTenant(t, tenantRegistry, TenantConfig{ID: "acme"}).WordSearchService()
+		globex := mustAddTenant(t, tenantRegistry, TenantConfig{ID: "globex"}).WordSearchService()
+
+		//it should count the words of every dictionary towards the limit (the default dictionary starts with 7 words)
+		french, err := wordSearchService.CreateDictionary("french")
+		assert.NoError(t, err)
+		assert.NoError(t, french.AddWords([]string{"un", "deux", "trois"}))
+		err = wordSearchService.AddWords([]string{"one", "two", "three"})
+		assert.Equal(t, ErrWordLimitExceeded, errors.Cause(err))
+		assert.EqualValues(t, []string{}, wordSearchService.SearchWord("three"))
+
+		//it should count the terms of documents towards the limit
+		documents := wordSearchService.Documents()
+		err = documents.IndexDocument("doc", "three terms here")
+		assert.Equal(t, ErrWordLimitExceeded, errors.Cause(err))
+		assert.NoError(t, documents.IndexDocument("doc", "two terms"))
+		assert.Equal(t, ErrWordLimitExceeded, errors.Cause(french.AddWords([]string{"quatre"})))
+
+		//it should give back the words of deleted documents and dictionaries
+		assert.NoError(t, documents.DeleteDocument("doc"))
+		assert.NoError(t, wordSearchService.AddWords([]string{"one", "two"}))
+		assert.NoError(t, wordSearchService.DeleteDictionary("french"))
+		assert.NoError(t, wordSearchService.AddWords([]string{"three", "four", "five"}))
+
+		//it should refuse dictionaries beyond the limit, including the default dictionary
+		_, err = wordSearchService.CreateDictionary("german")
+		assert.NoError(t, err)
+		_, err = wordSearchService.CreateDictionary("spanish")
+		assert.Equal(t, ErrDictionaryLimitExceeded, errors.Cause(err))
+
+		//it should keep each tenant's total separate
+		assert.NoError(t, globex.AddWords([]string{"one", "two", "three", "four", "five"}))
+	})
+	t.Run("per tenant quota", func(t *testing.T) {
+		tenantRegistry := NewTenantRegistry(TenantQuota{MaxDictionaryWords: 7, RequestsPerSecond: 1, RequestBurst: 1}, nil)
+		acme := mustAddTenant(t, tenantRegistry, TenantConfig{ID: "acme", Quota: &TenantQuota{MaxDictionaryWords: 8, MaxDictionaries: 1}})
+		globex := mustAddTenant(t, tenantRegistry, TenantConfig{ID: "globex"})
+
+		//it should apply a tenant's own quota in place of the registry's quota
+		assert.NoError(t, acme.WordSearchService().AddWords([]string{"one"}))
+		_, err := acme.WordSearchService().CreateDictionary("french")
+		assert.Equal(t, ErrDictionaryLimitExceeded, errors.Cause(err))
+		assert.True(t, acme.AllowRequest())
+		assert.True(t, acme.AllowRequest())
+
+		//it should apply the registry's quota to other tenants
+		assert.Error(t, globex.WordSearchService().AddWords([]string{"one"}))
+		_, err = globex.WordSearchService().CreateDictionary("french")
+		assert.NoError(t, err)
+		assert.True(t, globex.AllowRequest())
+		assert.False(t, globex.AllowRequest())
+	})
+	t.Run("request rate quota", func(t *testing.T) {
+		now := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+		tenantRegistry := NewTenantRegistry(TenantQuota{RequestsPerSecond: 1, RequestBurst: 2}, nil)
+		tenantRegistry.now = func() time.Time { return now }
+		acme := mustAddTenant(t, tenantRegistry, TenantConfig{ID: "acme"})
+		globex := mustAddTenant(t, tenantRegistry, TenantConfig{ID: "globex"})
+
+		//it should allow a burst of requests and then refuse further requests
+		assert.True(t, acme.AllowRequest())
+		assert.True(t, acme.AllowRequest())
+		assert.False(t, acme.AllowRequest())
+
+		//it should not let one tenant use up another tenant's requests
+		assert.True(t, globex.AllowRequest())
+
+		//it should allow requests again once time has passed
+		now = now.Add(time.Second)
+		assert.True(t, acme.AllowRequest())
+		assert.False(t, acme.AllowRequest())
+	})
+	t.Run("authentication", func(t *testing.T) {
+		tenantRegistry := NewTenantRegistry(TenantQuota{}, nil)
+		acmeKeyDigest := sha256.Sum256([]byte("acme-key"))
+		mustAddTenant(t, tenantRegistry, TenantConfig{ID: "acme", APIKeySHA256: []string{hex.EncodeToString(acmeKeyDigest[:])}})
+		mustAddTenant(t, tenantRegistry, TenantConfig{ID: DefaultTenantID})
+
+		//it should resolve the tenant an API key belongs to, with or without the tenant being named
+		tenant, err := tenantRegistry.Authenticate("", "acme-key")
+		assert.NoError(t, err)
+		assert.EqualValues(t, "acme", tenant.ID())
+		tenant, err = tenantRegistry.Authenticate("acme", "acme-key")
+		assert.NoError(t, err)
+		assert.EqualValues(t, "acme", tenant.ID())
+
+		//it should refuse a key used for another tenant, an unknown key, and a tenant with keys named without one
+		_, err = tenantRegistry.Authenticate(DefaultTenantID, "acme-key")
+		assert.Equal(t, ErrTenantNotFound, errors.Cause(err))
+		_, err = tenantRegistry.Authenticate("acme", "guess")
+		assert.Equal(t, ErrInvalidAPIKey, errors.Cause(err))
+		_, err = tenantRegistry.Authenticate("acme", "")
+		assert.Equal(t, ErrAPIKeyRequired, errors.Cause(err))
+
+		//it should serve a tenant without keys to requests which name it, but not an unknown tenant
+		tenant, err = tenantRegistry.Authenticate("", "")
+		assert.NoError(t, err)
+		assert.EqualValues(t, DefaultTenantID, tenant.ID())
+		_, err = tenantRegistry.Authenticate("initech", "")
+		assert.Equal(t, ErrTenantNotFound, errors.Cause(err))
+
+		//it should refuse malformed and shared key digests
+		_, err = tenantRegistry.AddTenant(TenantConfig{ID: "globex", APIKeySHA256: []string{"acme-key"}})
+		assert.Error(t, err)
+		_, err = tenantRegistry.AddTenant(TenantConfig{ID: "globex", APIKeySHA256: []string{hex.EncodeToString(acmeKeyDigest[:])}})
+		assert.Error(t, err)
+		_, err = tenantRegistry.AddTenant(TenantConfig{ID: "globex", AdminAPIKeySHA256: []string{hex.EncodeToString(acmeKeyDigest[:])}})
+		assert.Error(t, err)
+	})
+	t.Run("admin authentication", func(t *testing.T) {
+		tenantRegistry := NewTenantRegistry(TenantQuota{}, nil)
+		acmeKeyDigest := sha256.Sum256([]byte("acme-key"))
+		acmeAdminKeyDigest := sha256.Sum256([]byte("acme-admin-key"))
+		acme := mustAddTenant(t, tenantRegistry, TenantConfig{ID: "acme", APIKeySHA256: []string{hex.EncodeToString(acmeKeyDigest[:])}, AdminAPIKeySHA256: []string{hex.EncodeToString(acmeAdminKeyDigest[:])}})
+		globex := mustAddTenant(t, tenantRegistry, TenantConfig{ID: "globex", AdminAPIKeySHA256: []string{hex.EncodeToString(sha256.New().Sum(nil))}})
+
+		//it should authenticate an admin API key as its tenant
+		tenant, err := tenantRegistry.Authenticate("", "acme-admin-key")
+		assert.NoError(t, err)
+		assert.EqualValues(t, "acme", tenant.ID())
+
+		//it should only recognise the tenant's own admin API keys as admin keys
+		assert.True(t, acme.IsAdminAPIKey("acme-admin-key"))
+		assert.False(t, acme.IsAdminAPIKey("acme-key"))
+		assert.False(t, acme.IsAdminAPIKey(""))
+		assert.False(t, globex.IsAdminAPIKey("acme-admin-key"))
+
+		//it should require an API key for a tenant which only has admin API keys
+		_, err = tenantRegistry.Authenticate("globex", "")
+		assert.Equal(t, ErrAPIKeyRequired, errors.Cause(err))
+	})
+}
+
+//mustAddTenant - adds the tenant described by tenantConfig to tenantRegistry, failing the test if it cannot be added
+func mustAddTenant(t *testing.T, tenantRegistry *TenantRegistry, tenantConfig TenantConfig) *Tenant {
+	tenant, err := tenantRegistry.AddTenant(tenantConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tenant
+}
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"unicode"
+)
+
+//textToken - a word found in a text. Word is normalized with normalizeWord, the offsets locate the word as it was written in the text
+type textToken struct {
+	word      string
+	byteStart int
+	byteEnd   int
+	runeStart int
+	runeEnd   int
+}
+
+//wordBreakClass - the word break property of a character, as used by the word boundary rules of Unicode Standard Annex #29
+type wordBreakClass int
+
+const (
+	wordBreakOther wordBreakClass = iota
+	wordBreakLetter
+	wordBreakNumeric
+	wordBreakKatakana
+	//wordBreakIdeograph - Han and Hiragana, which have no spaces between words and so are treated as a word per character
+	wordBreakIdeograph
+	wordBreakExtendNumLet
+	//wordBreakExtend - combining marks and format characters, which stay with the character before them
+	wordBreakExtend
+	wordBreakMidLetter
+	wordBreakMidNum
+	wordBreakMidNumLet
+)
+
+//classifyWordBreak - returns the word break class of r
+func classifyWordBreak(r rune) wordBreakClass {
+	switch r {
+	case ':', '\u00b7', '\u0387', '\u05f4', '\u2027', '\ufe13', '\ufe55', '\uff1a':
+		return wordBreakMidLetter
+	case ',', ';', '\u037e', '\u0589', '\u060c', '\u060d', '\u066c', '\u07f8', '\u2044', '\ufe10', '\ufe14', '\ufe50', '\ufe54', '\uff0c', '\uff1b':
+		return wordBreakMidNum
+	case '.', '\'', '\u2018', '\u2019', '\u2024', '\ufe52', '\uff07', '\uff0e':
+		return wordBreakMidNumLet
+	case '\u200d':
+		return wordBreakExtend
+	case '\u3031', '\u3032', '\u3033', '\u3034', '\u3035', '\u309b', '\u309c', '\u30a0', '\u30fc', '\uff70':
+		//The kana marks shared by both scripts, such as the prolonged sound mark
+		return wordBreakKatakana
+	}
+	switch {
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc, unicode.Cf):
+		return wordBreakExtend
+	case unicode.Is(unicode.Han, r) || unicode.Is(unicode.Hiragana, r):
+		return wordBreakIdeograph
+	case unicode.Is(unicode.Katakana, r):
+		return wordBreakKatakana
+	case unicode.IsLetter(r):
+		return wordBreakLetter
+	case unicode.Is(unicode.Nd, r):
+		return wordBreakNumeric
+	case unicode.Is(unicode.Pc, r):
+		return wordBreakExtendNumLet
+	}
+	return wordBreakOther
+}
+
+//wordBreakJoins - reports whether there is no word boundary between characters of classes before and after, when they are next to each other
+func wordBreakJoins(before wordBreakClass, after wordBreakClass) bool {
+	alphanumeric := func(class wordBreakClass) bool {
+		return class == wordBreakLetter || class == wordBreakNumeric || class == wordBreakExtendNumLet
+	}
+	switch {
+	case alphanumeric(before) && alphanumeric(after):
+		return true
+	case before == wordBreakKatakana:
+		return after == wordBreakKatakana || after == wordBreakExtendNumLet
+	case after == wordBreakKatakana:
+		return before == wordBreakExtendNumLet
+	}
+	return false
+}
+
+//wordBreakJoinsAcross - reports whether a middle character of class middle joins the characters either side of it, as in "can't" or "3.14"
+func wordBreakJoinsAcross(before wordBreakClass, middle wordBreakClass, after wordBreakClass) bool {
+	switch {
+	case before == wordBreakLetter && after == wordBreakLetter:
+		return middle == wordBreakMidLetter || middle == wordBreakMidNumLet
+	case before == wordBreakNumeric && after == wordBreakNumeric:
+		return middle == wordBreakMidNum || middle == wordBreakMidNumLet
+	}
+	return false
+}
+
+//segmentRune - a character of the text being segmented, with its position and word break class
+type segmentRune struct {
+	byteOffset int
+	class      wordBreakClass
+}
+
+//tokenizeText - splits text into its words following the word boundary rules of Unicode Standard Annex #29.
+// Letters, numbers and connectors such as _ join into words, punctuation such as the apostrophe in "can't" or the point in "3.14" joins the letters or numbers either side of it,
+// and combining marks stay with the character before them. Han and Hiragana characters are a word each, as finding the words between them needs a dictionary.
+// Segments which hold no letters or numbers, such as spaces and punctuation, are not words and are left out
+func tokenizeText(text string) []textToken {
+	runes := make([]segmentRune, 0, len(text))
+	for byteOffset, r := range text {
+		runes = append(runes, segmentRune{byteOffset: byteOffset, class: classifyWordBreak(r)})
+	}
+	//next - returns the position of the first character after i which is not an Extend character
+	next := func(i int) int {
+		for i++; i < len(runes) && runes[i].class == wordBreakExtend; i++ {
+		}
+		return i
+	}
+
+	tokens := make([]textToken, 0)
+	for start := 0; start < len(runes); {
+		class := runes[start].class
+		end := next(start)
+		isWord := class != wordBreakOther && class != wordBreakExtend && class != wordBreakMidLetter && class != wordBreakMidNum && class != wordBreakMidNumLet
+		if isWord && class != wordBreakIdeograph {
+			//Extend the word for as long as there is no boundary
+			last := class
+			for end < len(runes) {
+				if wordBreakJoins(last, runes[end].class) {
+					last = runes[end].class
+					end = next(end)
+					continue
+				}
+				after := next(end)
+				if after < len(runes) && wordBreakJoinsAcross(last, runes[end].class, runes[after].class) {
+					last = runes[after].class
+					end = next(after)
+					continue
+				}
+				break
+			}
+		}
+
+		//A run of connectors on its own, such as "___", is not a word
+		hasLetterOrNumber := false
+		for i := start; i < end; i++ {
+			switch runes[i].class {
+			case wordBreakLetter, wordBreakNumeric, wordBreakKatakana, wordBreakIdeograph:
+				hasLetterOrNumber = true
+			}
+		}
+		if isWord && hasLetterOrNumber {
+			byteEnd := len(text)
+			if end < len(runes) {
+				byteEnd = runes[end].byteOffset
+			}
+			tokens = append(tokens, textToken{
+				word:      normalizeWord(text[runes[start].byteOffset:byteEnd]),
+				byteStart: runes[start].byteOffset,
+				byteEnd:   byteEnd,
+				runeStart: start,
+				runeEnd:   end,
+			})
+		}
+		start = end
+	}
+	return tokens
+}
+
+//DefaultStopwords - common English words which are left out of the words extracted from a text
+var DefaultStopwords = map[string]bool{
+	"a": true, "about": true, "after": true, "all": true, "also": true, "an": true, "and": true, "any": true, "are": true, "as": true,
+	"at": true, "be": true, "been": true, "but": true, "by": true, "can": true, "could": true, "did": true, "do": true, "does": true,
+	"for": true, "from": true, "had": true, "has": true, "have": true, "he": true, "her": true, "his": true, "how": true, "i": true,
+	"if": true, "in": true, "into": true, "is": true, "it": true, "its": true, "me": true, "my": true, "no": true, "not": true,
+	"of": true, "on": true, "or": true, "our": true, "she": true, "so": true, "than": true, "that": true, "the": true, "their": true,
+	"them": true, "then": true, "there": true, "these": true, "they": true, "this": true, "to": true, "up": true, "us": true, "was": true,
+	"we": true, "were": true, "what": true, "when": true, "which": true, "who": true, "will": true, "with": true, "would": true, "you": true,
+	"your": true,
+}
+
+//TokenizeOptions - optional parameters for TokenizeText
+type TokenizeOptions struct {
+	//KeepStopwords - when true, stopwords are kept rather than left out
+	KeepStopwords bool
+	//Stopwords - the stopwords to leave out, in normalized form. DefaultStopwords is used when nil
+	Stopwords map[string]bool
+	//IncludeFrequencies - when true, each word carries the number of times it appears in the text
+	IncludeFrequencies bool
+}
+
+//TextWord - a distinct word found in a text
+type TextWord struct {
+	Word string
+	//Frequency - the number of times the word appears in the text. Only set when IncludeFrequencies is requested
+	Frequency int
+}
+
+//TokenizeText - returns the distinct words of text in the order they first appear, normalized the same way as AddWords normalizes words
+func TokenizeText(text string, options TokenizeOptions) []TextWord {
+	stopwords := options.Stopwords
+	if stopwords == nil {
+		stopwords = DefaultStopwords
+	}
+
+	words := make([]TextWord, 0)
+	positions := make(map[string]int)
+	for _, token := range tokenizeText(text) {
+		if !options.KeepStopwords && stopwords[token.word] {
+			continue
+		}
+		i, seen := positions[token.word]
+		if !seen {
+			i = len(words)
+			positions[token.word] = i
+			words = append(words, TextWord{Word: token.word})
+		}
+		if options.IncludeFrequencies {
+			words[i].Frequency++
+		}
+	}
+	return words
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenizeText(t *testing.T) {
+	//it should split text into normalized words, recording where each word was found
+	tokens := tokenizeText("Héllo, wörld! 42x")
+	assert.EqualValues(t, []textToken{
+		{word: "héllo", byteStart: 0, byteEnd: 6, runeStart: 0, runeEnd: 5},
+		{word: "wörld", byteStart: 8, byteEnd: 14, runeStart: 7, runeEnd: 12},
+		{word: "42x", byteStart: 16, byteEnd: 19, runeStart: 14, runeEnd: 17},
+	}, tokens)
+}
+
+func TestWordBoundaries(t *testing.T) {
+	tests := map[string][]string{
+		//it should keep apostrophes and points between letters or numbers in the word
+		"Don't pay $3.50 for 1,000 e.g. items": {"don't", "pay", "3.50", "for", "1,000", "e.g", "items"},
+		//it should join letters, numbers and connectors
+		"snake_case route66 __": {"snake_case", "route66"},
+		//it should end words at punctuation which is not between letters
+		"'quoted' end. (brackets) a-b": {"quoted", "end", "brackets", "a", "b"},
+		//it should keep combining marks with their letter
+		"café naïve": {"café", "naïve"},
+		//it should make each ideograph a word, and keep katakana together
+		"東京タワー": {"東", "京", "タワー"},
+	}
+	for text, words := range tests {
+		tokens := tokenizeText(text)
+		tokenWords := make([]string, len(tokens))
+		for i := range tokens {
+			tokenWords[i] = tokens[i].word
+		}
+		assert.EqualValues(t, words, tokenWords, text)
+	}
+}
+
+func TestTokenizeTextWords(t *testing.T) {
+	text := "The cat and the Hat. The CAT sat!"
+
+	//it should return the distinct words in the order they appear, leaving out stopwords
+	assert.EqualValues(t, []TextWord{{Word: "cat"}, {Word: "hat"}, {Word: "sat"}}, TokenizeText(text, TokenizeOptions{}))
+
+	//it should count each word when asked to
+	assert.EqualValues(t, []TextWord{{"the", 3}, {"cat", 2}, {"and", 1}, {"hat", 1}, {"sat", 1}}, TokenizeText(text, TokenizeOptions{KeepStopwords: true, IncludeFrequencies: true}))
+
+	//it should use the given stopwords
+	assert.EqualValues(t, []TextWord{{Word: "the"}, {Word: "and"}, {Word: "hat"}, {Word: "sat"}}, TokenizeText(text, TokenizeOptions{Stopwords: map[string]bool{"cat": true}}))
+}
+
+func TestWordDictionary_AddWordsFromText(t *testing.T) {
+	wordDictionary := NewWordDictionary("test")
+	wordDictionary.AddWords([]string{"cat"})
+
+	//it should add the new words of the text, counting the words already in the dictionary
+	summary, version := wordDictionary.AddWordsFromText("The cat sat on the mat. The cat was happy.", TokenizeOptions{})
+	assert.EqualValues(t, AddWordsSummary{Added: 3, Duplicates: 1}, summary)
+	assert.EqualValues(t, 2, version)
+	assert.EqualValues(t, []string{"cat", "happy", "mat", "sat"}, wordDictionary.SearchWord(""))
+}
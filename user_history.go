@@ -0,0 +1,204 @@
+package main
+
+import (
+	"container/list"
+	"sort"
+	"strings"
+)
+
+//maxUserHistories - the number of users whose searches a dictionary keeps. The user who searched least recently makes way for a new one
+const maxUserHistories = 10000
+
+//maxRecentSearchesPerUser - the number of distinct keywords a user's recent searches hold
+const maxRecentSearchesPerUser = 20
+
+//maxFrequentSearchesPerUser - the number of distinct keywords whose searches are counted for a user. The least searched keyword makes way for a new one
+const maxFrequentSearchesPerUser = 50
+
+//personalizationWeight - the share of a personalized suggestion's score which comes from the user's own history, the rest coming from every user's searches
+const personalizationWeight = 0.7
+
+//defaultPersonalizedSuggestions - the number of suggestions PersonalizedSuggestions returns when no limit is given
+const defaultPersonalizedSuggestions = 5
+
+//userSearchHistory - the keywords a user has searched for in a dictionary, bounded so that it does not grow with the user's activity
+type userSearchHistory struct {
+	userID string
+	//recent - the distinct keywords searched most recently, most recent first
+	recent []string
+	//counts - the number of times each of the user's most frequent keywords was searched
+	counts map[string]int64
+}
+
+//newUserSearchHistory - creates an empty userSearchHistory for the user with userID
+func newUserSearchHistory(userID string) *userSearchHistory {
+	newUserSearchHistory := new(userSearchHistory)
+	newUserSearchHistory.userID = userID
+	newUserSearchHistory.recent = make([]string, 0, maxRecentSearchesPerUser)
+	newUserSearchHistory.counts = make(map[string]int64)
+	return newUserSearchHistory
+}
+
+//record - adds a search for the lowercase keyword to the history
+func (history *userSearchHistory) record(lowercaseKeyWord string) {
+	//Move the keyword to the front of the recent searches
+	for i := range history.recent {
+		if history.recent[i] == lowercaseKeyWord {
+			history.recent = append(history.recent[:i], history.recent[i+1:]...)
+			break
+		}
+	}
+	history.recent = append([]string{lowercaseKeyWord}, history.recent...)
+	if len(history.recent) > maxRecentSearchesPerUser {
+		history.recent = history.recent[:maxRecentSearchesPerUser]
+	}
+
+	history.counts[lowercaseKeyWord]++
+	if len(history.counts) > maxFrequentSearchesPerUser {
+		//Forget the least searched of the other keywords, the alphabetically last among equals
+		evicted := ""
+		for keyWord, count := range history.counts {
+			if keyWord == lowercaseKeyWord {
+				continue
+			}
+			if evicted == "" || count < history.counts[evicted] || (count == history.counts[evicted] && keyWord > evicted) {
+				evicted = keyWord
+			}
+		}
+		delete(history.counts, evicted)
+	}
+}
+
+//userSearchHistories - the search histories of a dictionary's users, bounded to the users who searched most recently
+type userSearchHistories struct {
+	histories map[string]*list.Element
+	//order - the histories, the most recently searched first
+	order *list.List
+}
+
+//newUserSearchHistories - creates an empty userSearchHistories
+func newUserSearchHistories() *userSearchHistories {
+	newUserSearchHistories := new(userSearchHistories)
+	newUserSearchHistories.histories = make(map[string]*list.Element)
+	newUserSearchHistories.order = list.New()
+	return newUserSearchHistories
+}
+
+//get - returns the history of the user with userID, or nil if there is none
+func (histories *userSearchHistories) get(userID string) *userSearchHistory {
+	element := histories.histories[userID]
+	if element == nil {
+		return nil
+	}
+	return element.Value.(*userSearchHistory)
+}
+
+//record - adds a search for the lowercase keyword to the history of the user with userID,
+// forgetting the user who searched least recently if there are too many histories
+func (histories *userSearchHistories) record(userID string, lowercaseKeyWord string) {
+	element := histories.histories[userID]
+	if element == nil {
+		element = histories.order.PushFront(newUserSearchHistory(userID))
+		histories.histories[userID] = element
+		if histories.order.Len() > maxUserHistories {
+			histories.erase(histories.order.Back().Value.(*userSearchHistory).userID)
+		}
+	} else {
+		histories.order.MoveToFront(element)
+	}
+	element.Value.(*userSearchHistory).record(lowercaseKeyWord)
+}
+
+//erase - forgets the history of the user with userID
+func (histories *userSearchHistories) erase(userID string) {
+	if element := histories.histories[userID]; element != nil {
+		histories.order.Remove(element)
+		delete(histories.histories, userID)
+	}
+}
+
+//PersonalizedSuggestions - returns up to limit keywords starting with prefix for the user with userID to search for, best first.
+// Keywords are ranked by a blend of how recently and how often the user searched them and how often every user searched them,
+// so a user with no history gets the dictionary's most searched keywords. A limit of 0 or less returns up to 5 keywords
+func (wordDictionary *WordDictionary) PersonalizedSuggestions(userID string, prefix string, limit int) []string {
+	if limit <= 0 {
+		limit = defaultPersonalizedSuggestions
+	}
+	lowercasePrefix := normalizeWord(prefix)
+
+	wordDictionary.mutex.Lock()
+	defer wordDictionary.mutex.Unlock()
+
+	scores := make(map[string]float64)
+
+	//Score every user's searches relative to the most searched keyword
+	var maxGlobalCount int64
+	for _, keyWordStat := range wordDictionary.keyWordStats {
+		if keyWordStat.numberOfTimesSearched > maxGlobalCount {
+			maxGlobalCount = keyWordStat.numberOfTimesSearched
+		}
+	}
+	for _, keyWordStat := range wordDictionary.keyWordStats {
+		if strings.HasPrefix(keyWordStat.word, lowercasePrefix) {
+			scores[keyWordStat.word] += (1 - personalizationWeight) * float64(keyWordStat.numberOfTimesSearched) / float64(maxGlobalCount)
+		}
+	}
+
+	//Score the user's own searches by recency and by frequency relative to their most searched keyword
+	if history := wordDictionary.userHistories.get(userID); userID != "" && history != nil {
+		for i, keyWord := range history.recent {
+			if strings.HasPrefix(keyWord, lowercasePrefix) {
+				scores[keyWord] += personalizationWeight / 2 * float64(len(history.recent)-i) / float64(len(history.recent))
+			}
+		}
+		var maxUserCount int64
+		for _, count := range history.counts {
+			if count > maxUserCount {
+				maxUserCount = count
+			}
+		}
+		for keyWord, count := range history.counts {
+			if strings.HasPrefix(keyWord, lowercasePrefix) {
+				scores[keyWord] += personalizationWeight / 2 * float64(count) / float64(maxUserCount)
+			}
+		}
+	}
+
+	//Blocked keywords are still scored so that they rank correctly if unblocked
+	suggestions := make([]string, 0, len(scores))
+	for keyWord := range scores {
+		if !wordDictionary.blocklist.IsBlocked(keyWord) {
+			suggestions = append(suggestions, keyWord)
+		}
+	}
+	sort.Slice(suggestions, func(i, j int) bool {
+		if scores[suggestions[i]] != scores[suggestions[j]] {
+			return scores[suggestions[i]] > scores[suggestions[j]]
+		}
+		return suggestions[i] < suggestions[j]
+	})
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions
+}
+
+//EraseUserHistory - forgets the searches of the user with userID, along with their search sessions.
+// The searches still count towards every user's statistics and query refinements, which do not say who searched
+func (wordDictionary *WordDictionary) EraseUserHistory(userID string) {
+	wordDictionary.mutex.Lock()
+	defer wordDictionary.mutex.Unlock()
+
+	wordDictionary.userHistories.erase(userID)
+	wordDictionary.searchSessions.erase(userID)
+}
+
+//EraseUserHistory - forgets the searches of the user with userID in every dictionary
+func (wordSearchService *WordSearchService) EraseUserHistory(userID string) {
+	wordSearchService.mutex.RLock()
+	defer wordSearchService.mutex.RUnlock()
+
+	for _, dictionary := range wordSearchService.dictionaries {
+		dictionary.EraseUserHistory(userID)
+	}
+}
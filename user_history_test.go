@@ -0,0 +1,98 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPersonalizedSuggestions(t *testing.T) {
+	t.Run("blend test", func(t *testing.T) {
+		wordDictionary := NewWordDictionary("test")
+		wordDictionary.AddWords([]string{"cat", "car", "cab", "dog"})
+		for i := 0; i < 5; i++ {
+			wordDictionary.Search("cat", SearchOptions{})
+		}
+		wordDictionary.Search("car", SearchOptions{UserID: "alice"})
+		wordDictionary.Search("car", SearchOptions{UserID: "alice"})
+		wordDictionary.Search("cab", SearchOptions{UserID: "alice"})
+
+		//it should favour the user's own recent and frequent searches
+		assert.EqualValues(t, []string{"car", "cab", "cat"}, wordDictionary.PersonalizedSuggestions("alice", "", 0))
+
+		//it should fall back to every user's searches for a user with no history
+		assert.EqualValues(t, []string{"cat", "car", "cab"}, wordDictionary.PersonalizedSuggestions("bob", "", 0))
+		assert.EqualValues(t, []string{"cat", "car", "cab"}, wordDictionary.PersonalizedSuggestions("", "", 0))
+
+		//it should only suggest keywords starting with the prefix, up to the limit
+		assert.EqualValues(t, []string{"car"}, wordDictionary.PersonalizedSuggestions("alice", "CA", 1))
+		assert.Empty(t, wordDictionary.PersonalizedSuggestions("alice", "d", 0))
+
+		//it should not record a search for a later page in the history
+		results, _ := wordDictionary.Search("ca", SearchOptions{PageSize: 1})
+		wordDictionary.Search("ca", SearchOptions{PageSize: 1, PageToken: results.NextPageToken, UserID: "alice"})
+		assert.NotContains(t, wordDictionary.userHistories.get("alice").recent, "ca")
+
+		//it should forget the user's searches once erased, keeping them in every user's statistics
+		wordDictionary.EraseUserHistory("alice")
+		assert.EqualValues(t, []string{"cat", "car", "ca", "cab"}, wordDictionary.PersonalizedSuggestions("alice", "", 0))
+
+		//it should forget the user's search sessions once erased, and only that user's
+		wordDictionary.Search("dog", SearchOptions{UserID: "alice", SessionID: "s1"})
+		wordDictionary.Search("dog", SearchOptions{UserID: "bob", SessionID: "s2"})
+		wordDictionary.EraseUserHistory("alice")
+		assert.Empty(t, wordDictionary.SessionQueries("s1"))
+		assert.Len(t, wordDictionary.SessionQueries("s2"), 1)
+	})
+	t.Run("bounded history test", func(t *testing.T) {
+		wordDictionary := NewWordDictionary("test")
+		for i := 0; i < 3; i++ {
+			wordDictionary.Search("keep", SearchOptions{UserID: "alice"})
+		}
+		for i := 0; i < maxFrequentSearchesPerUser+10; i++ {
+			wordDictionary.Search(fmt.Sprintf("word%02d", i), SearchOptions{UserID: "alice"})
+		}
+
+		//it should bound the recent and frequent searches, keeping the most recent and the most frequent
+		history := wordDictionary.userHistories.get("alice")
+		assert.Len(t, history.recent, maxRecentSearchesPerUser)
+		assert.EqualValues(t, fmt.Sprintf("word%02d", maxFrequentSearchesPerUser+9), history.recent[0])
+		assert.Len(t, history.counts, maxFrequentSearchesPerUser)
+		assert.EqualValues(t, 3, history.counts["keep"])
+
+		//it should move a repeated search to the front without duplicating it
+		wordDictionary.Search("word55", SearchOptions{UserID: "alice"})
+		assert.EqualValues(t, []string{"word55", "word59", "word58"}, history.recent[:3])
+		assert.Len(t, history.recent, maxRecentSearchesPerUser)
+	})
+	t.Run("bounded users test", func(t *testing.T) {
+		wordDictionary := NewWordDictionary("test")
+		for i := 0; i < maxUserHistories; i++ {
+			wordDictionary.Search("cat", SearchOptions{UserID: fmt.Sprintf("user%05d", i)})
+		}
+		wordDictionary.Search("dog", SearchOptions{UserID: "user00000"})
+		wordDictionary.Search("cat", SearchOptions{UserID: "alice"})
+
+		//it should forget the user who searched least recently once there are too many users
+		assert.EqualValues(t, maxUserHistories, wordDictionary.userHistories.order.Len())
+		assert.Len(t, wordDictionary.userHistories.histories, maxUserHistories)
+		assert.Nil(t, wordDictionary.userHistories.get("user00001"))
+		assert.NotNil(t, wordDictionary.userHistories.get("user00000"))
+		assert.NotNil(t, wordDictionary.userHistories.get("alice"))
+	})
+	t.Run("service test", func(t *testing.T) {
+		wordSearchService := NewWordSearchService()
+		first, _ := wordSearchService.CreateDictionary("first")
+		second, _ := wordSearchService.CreateDictionary("second")
+		first.Search("apple", SearchOptions{UserID: "alice"})
+		second.Search("pear", SearchOptions{UserID: "alice"})
+		second.Search("plum", SearchOptions{UserID: "bob"})
+
+		//it should erase the user's history from every dictionary, and only that user's
+		wordSearchService.EraseUserHistory("alice")
+		assert.Zero(t, first.userHistories.order.Len())
+		assert.Nil(t, second.userHistories.get("alice"))
+		assert.NotNil(t, second.userHistories.get("bob"))
+	})
+}
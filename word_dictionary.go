@@ -0,0 +1,603 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/text/unicode/norm"
+)
+
+//
+//HELPER STRUCTURES
+//
+
+//keyWordStat - represents a word and any of its associated metadata
+type keyWordStat struct {
+	word                  string
+	numberOfTimesSearched int64
+}
+
+//keyWordStatSlice - a slice of keyWordStat struct
+type keyWordStatSlice []*keyWordStat
+
+//alphabeticalKeyWordStatSlice - a keyWordStatSlice that can be passed to sort.Sort() to sort words that are alphabetically higher to the start of the slice
+type alphabeticalKeyWordStatSlice keyWordStatSlice
+
+func (p alphabeticalKeyWordStatSlice) Len() int { return len(p) }
+func (p alphabeticalKeyWordStatSlice) Less(i, j int) bool {
+	return p[i].word < p[j].word
+}
+func (p alphabeticalKeyWordStatSlice) Swap(i, j int) { p[i], p[j] = p[j], p[i] }
+
+//searchFrequencyKeyWordStatSlice - a keyWordStatSlice that can be passed to sort.Sort() to sort words that are more frequently searched to the start of the slice
+type searchFrequencyKeyWordStatSlice keyWordStatSlice
+
+func (p searchFrequencyKeyWordStatSlice) Len() int { return len(p) }
+func (p searchFrequencyKeyWordStatSlice) Less(i, j int) bool {
+	return p[i].numberOfTimesSearched > p[j].numberOfTimesSearched
+}
+func (p searchFrequencyKeyWordStatSlice) Swap(i, j int) { p[i], p[j] = p[j], p[i] }
+
+//ErrWordLimitExceeded - returned when adding words would take a dictionary over its word limit, or its tenant over the tenant word limit
+var ErrWordLimitExceeded = errors.New("word limit exceeded")
+
+//
+//WordDictionary
+//
+
+//WordDictionary - a named collection of words which can be added and searched, along with statistics on the keywords searched in it
+type WordDictionary struct {
+	name            string
+	mutex           sync.Mutex
+	dictionaryWords map[string]*wordEntry
+	sortedWords     sortedWordIndex
+	stemIndex       *stemIndex
+	tokenIndex      tokenIndex
+	completions     *completionTrie
+	keyWordStatsMap map[string]*keyWordStat
+	keyWordStats    []*keyWordStat
+	userHistories   *userSearchHistories
+	searchSessions  *searchSessions
+	wordLimit       int
+	wordQuota       *wordQuota
+	wordValidator   *WordValidator
+	blocklist       *Blocklist
+	synonyms        *SynonymGroups
+	version         int64
+	versions        []*dictionaryVersion
+	now             func() time.Time
+}
+
+//SearchOptions - optional parameters for WordDictionary.Search
+type SearchOptions struct {
+	//Version - when not 0, the search is made against the dictionary as it was at this version
+	Version int64
+	//IncludeMetadata - when true, each match carries the metadata of the word
+	IncludeMetadata bool
+	//PartOfSpeech - when set, only words with this part of speech match
+	PartOfSpeech string
+	//Tags - when set, only words carrying every one of these tags match
+	Tags []string
+	//PageSize - when not 0, at most this many matches are returned, and NextPageToken is set if there are more
+	PageSize int
+	//PageToken - the NextPageToken of the previous page, or "" for the first page. When ranking by relevance, a word whose score rises between pages may be skipped
+	PageToken string
+	//IncludeHighlights - when true, each match carries the spans of the word which match the keyword
+	IncludeHighlights bool
+	//Stemming - when true, matches are the words sharing the keyword's stem, so that "running" finds "run" and "runs", rather than the words containing the keyword
+	Stemming bool
+	//MatchMode - how the keyword is matched against the words. Ignored for stemmed searches
+	MatchMode MatchMode
+	//ExpandSynonyms - when true, the words matching the keyword's synonyms are also matched
+	ExpandSynonyms bool
+	//RankByRelevance - when true, matches are ordered by their score, highest first, rather than alphabetically
+	RankByRelevance bool
+	//Scorer - the Scorer used when ranking by relevance. DefaultScorer is used when nil
+	Scorer Scorer
+	//UserID - when set, the keyword is also added to this user's search history, see PersonalizedSuggestions
+	UserID string
+	//SessionID - when set, the keyword is also added to this search session's queries, see QueryRefinements
+	SessionID string
+}
+
+//scorer - returns the Scorer to rank matches with, or nil if the matches are ordered alphabetically
+func (options SearchOptions) scorer() Scorer {
+	if !options.RankByRelevance {
+		return nil
+	}
+	if options.Scorer == nil {
+		return DefaultScorer
+	}
+	return options.Scorer
+}
+
+//SearchMatch - a dictionary word which matched a search
+type SearchMatch struct {
+	Word string
+	//Score - the relevance of the word to the search. Only set when ranking by relevance
+	Score    float64
+	Metadata *WordMetadata
+	//ExpandedFrom - the synonym of the keyword the word matched through, "" when the word matched the keyword itself
+	ExpandedFrom string
+	//Highlights - the rune offsets of every part of the word which matches the keyword. Only set when IncludeHighlights is requested
+	Highlights []HighlightSpan
+}
+
+//SearchResults - the outcome of WordDictionary.Search
+type SearchResults struct {
+	//Version - the dictionary version which was searched
+	Version int64
+	Matches []SearchMatch
+	//TotalMatches - the number of matches across all pages
+	TotalMatches int
+	//NextPageToken - passed as SearchOptions.PageToken to get the next page, "" on the last page
+	NextPageToken string
+}
+
+//NewWordDictionary creates a new, empty instance of WordDictionary
+func NewWordDictionary(name string) *WordDictionary {
+	newWordDictionary := new(WordDictionary)
+	newWordDictionary.name = name
+	newWordDictionary.dictionaryWords = make(map[string]*wordEntry)
+	newWordDictionary.stemIndex = newStemIndex(stemmers[DefaultStemmingLanguage])
+	newWordDictionary.completions = newCompletionTrie(DefaultAutocompleteBlendWeight)
+	newWordDictionary.userHistories = newUserSearchHistories()
+	newWordDictionary.searchSessions = newSearchSessions()
+	newWordDictionary.keyWordStatsMap = make(map[string]*keyWordStat)
+	newWordDictionary.keyWordStats = make([]*keyWordStat, 0, 0)
+	newWordDictionary.versions = make([]*dictionaryVersion, 0)
+	newWordDictionary.now = time.Now
+	return newWordDictionary
+}
+
+//Name - returns the name of the dictionary
+func (wordDictionary *WordDictionary) Name() string {
+	return wordDictionary.name
+}
+
+//SetWordLimit - limits the number of words the dictionary may hold. A limit of 0 means the dictionary is unlimited
+func (wordDictionary *WordDictionary) SetWordLimit(wordLimit int) {
+	wordDictionary.mutex.Lock()
+	defer wordDictionary.mutex.Unlock()
+
+	wordDictionary.wordLimit = wordLimit
+}
+
+//hasRoomFor - reports whether numberOfWords more words can be added without exceeding the word limit
+func (wordDictionary *WordDictionary) hasRoomFor(numberOfWords int) bool {
+	return wordDictionary.wordLimit <= 0 || len(wordDictionary.dictionaryWords)+numberOfWords <= wordDictionary.wordLimit
+}
+
+//SearchWord - returns possible matches for the keyword provided
+func (wordDictionary *WordDictionary) SearchWord(keyWord string) (matches []string) {
+	searchResults, _ := wordDictionary.Search(keyWord, SearchOptions{})
+	possibleMatches := make([]string, len(searchResults.Matches))
+	for i := range searchResults.Matches {
+		possibleMatches[i] = searchResults.Matches[i].Word
+	}
+	return possibleMatches
+}
+
+//Search - returns possible matches for the keyword provided, as determined by options
+func (wordDictionary *WordDictionary) Search(keyWord string, options SearchOptions) (searchResults *SearchResults, err error) {
+	wordDictionary.mutex.Lock()
+	defer wordDictionary.mutex.Unlock()
+
+	//convert the keyword to lowercase
+	lowercaseKeyWord := normalizeWord(keyWord)
+
+	//Decode the page token before anything is recorded
+	pageCursor, err := decodeSearchPageToken(options.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	//Pick the version of the dictionary to search
+	version := wordDictionary.version
+	dictionaryWords := wordDictionary.dictionaryWords
+	if options.Version != 0 && options.Version != wordDictionary.version {
+		version = options.Version
+		dictionaryWords, err = wordDictionary.wordsAtVersion(version)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	//record the the key word as being searches, once per search rather than once per page
+	if options.PageToken == "" {
+		wordDictionary.recordKeyWord(lowercaseKeyWord, options)
+	}
+
+	//Check if the word does not exist
+	matcher := wordDictionary.newWordMatcher(lowercaseKeyWord, options)
+	candidates := dictionaryWords
+	if options.Stemming && version == wordDictionary.version {
+		//Only the words sharing the stem of the keyword or a synonym can match. The stem index only covers the current version
+		candidates = wordDictionary.stemIndex.entriesWithStems(matcher.termStems(), dictionaryWords)
+	} else if prefixes, ok := matcher.firstTokens(); ok && version == wordDictionary.version {
+		//Only the words with a token starting with the first token of the keyword or a synonym can match
+		candidates = wordDictionary.tokenIndex.entriesWithTokenPrefixes(prefixes, dictionaryWords)
+	}
+	matches := make([]SearchMatch, 0, len(candidates))
+	for dictionaryWord, entry := range candidates {
+		if expandedFrom, ok := matcher.match(dictionaryWord, entry); ok {
+			matches = append(matches, SearchMatch{Word: dictionaryWord, ExpandedFrom: expandedFrom})
+		}
+	}
+
+	//Score the matches when ranking by relevance
+	scorer := options.scorer()
+	if scorer != nil {
+		for i := range matches {
+			matches[i].Score = scorer.Score(wordDictionary.scoreCandidate(matcher.scoreTerm(matches[i].ExpandedFrom), matches[i].Word, matcher.matchPosition(matches[i].Word, matches[i].ExpandedFrom)))
+		}
+	}
+
+	//Order the matches alphabetically, or by score when ranking by relevance
+	sort.Slice(matches, func(i, j int) bool { return matchOrderLess(matches[i], matches[j], scorer != nil) })
+
+	//Cut out the requested page
+	totalMatches := len(matches)
+	matches, nextPageCursor := searchPage(matches, pageCursor, options.PageSize, scorer != nil)
+
+	for i := range matches {
+		if options.IncludeMetadata {
+			matches[i].Metadata = dictionaryWords[matches[i].Word].metadata.clone()
+		}
+		if options.IncludeHighlights {
+			matches[i].Highlights = matcher.highlights(matches[i].Word, matches[i].ExpandedFrom)
+		}
+	}
+	return &SearchResults{
+		Version:       version,
+		Matches:       matches,
+		TotalMatches:  totalMatches,
+		NextPageToken: encodeSearchPageToken(nextPageCursor),
+	}, nil
+}
+
+//wordMatcher - decides whether a dictionary word matches a search
+type wordMatcher struct {
+	lowercaseKeyWord string
+	metadataFilter   wordMetadataFilter
+	blocklist        *Blocklist
+	now              time.Time
+	//stemmer - set for stemmed searches, which match words by stem rather than by containing the keyword
+	stemmer Stemmer
+	mode    MatchMode
+	//terms - the keyword, followed by its synonyms when the search expands synonyms
+	terms []matchTerm
+}
+
+//matchTerm - a term a word may match, along with its stem for stemmed searches and its tokens for token-aware searches
+type matchTerm struct {
+	text   string
+	stem   string
+	tokens []textToken
+}
+
+//newWordMatcher - creates a wordMatcher for a search of the dictionary. The caller must hold the mutex
+func (wordDictionary *WordDictionary) newWordMatcher(lowercaseKeyWord string, options SearchOptions) wordMatcher {
+	matcher := wordMatcher{
+		lowercaseKeyWord: lowercaseKeyWord,
+		metadataFilter:   newWordMetadataFilter(options.PartOfSpeech, options.Tags),
+		blocklist:        wordDictionary.blocklist,
+		now:              wordDictionary.now(),
+	}
+	if options.Stemming {
+		matcher.stemmer = wordDictionary.stemIndex.stemmer
+	} else {
+		matcher.mode = options.MatchMode
+	}
+	terms := []string{lowercaseKeyWord}
+	if options.ExpandSynonyms && matcher.stemmer != nil {
+		//"cars" is expanded by the groups of "car"
+		terms = append(terms, wordDictionary.synonyms.StemmedSynonyms(lowercaseKeyWord, matcher.stemmer)...)
+	} else if options.ExpandSynonyms {
+		terms = append(terms, wordDictionary.synonyms.Synonyms(lowercaseKeyWord)...)
+	}
+	for _, term := range terms {
+		_matchTerm := matchTerm{text: term, stem: matcher.stem(term)}
+		if matcher.mode != MatchSubstring {
+			_matchTerm.tokens = tokenizeText(term)
+		}
+		matcher.terms = append(matcher.terms, _matchTerm)
+	}
+	return matcher
+}
+
+//matches - reports whether the dictionary word with entry matches the search
+func (matcher wordMatcher) matches(dictionaryWord string, entry *wordEntry) bool {
+	_, ok := matcher.match(dictionaryWord, entry)
+	return ok
+}
+
+//match - reports whether the dictionary word with entry matches the search, along with the synonym it matched through ("" when it matched the keyword itself)
+func (matcher wordMatcher) match(dictionaryWord string, entry *wordEntry) (expandedFrom string, ok bool) {
+	if entry.expired(matcher.now) || !matcher.metadataFilter.matches(entry.metadata) || matcher.blocklist.IsBlocked(dictionaryWord) {
+		return "", false
+	}
+	for i, term := range matcher.terms {
+		if matcher.matchesTerm(dictionaryWord, term) {
+			if i == 0 {
+				return "", true
+			}
+			return term.text, true
+		}
+	}
+	return "", false
+}
+
+//matchesTerm - reports whether the dictionary word contains the term, shares its stem for a stemmed search, or matches its tokens for a token-aware search
+func (matcher wordMatcher) matchesTerm(dictionaryWord string, term matchTerm) bool {
+	if matcher.stemmer != nil {
+		return matcher.stemmer.Stem(dictionaryWord) == term.stem
+	}
+	if matcher.mode != MatchSubstring {
+		_, ok := matchTokenRun(tokenizeText(dictionaryWord), term.tokens, matcher.mode == MatchTokenPrefix)
+		return ok
+	}
+	return strings.Contains(dictionaryWord, term.text)
+}
+
+//stem - returns the stem of the term for a stemmed search, or "" otherwise
+func (matcher wordMatcher) stem(term string) string {
+	if matcher.stemmer == nil {
+		return ""
+	}
+	return matcher.stemmer.Stem(term)
+}
+
+//termStems - returns the stems of the keyword and its synonyms for a stemmed search
+func (matcher wordMatcher) termStems() []string {
+	stems := make([]string, len(matcher.terms))
+	for i := range matcher.terms {
+		stems[i] = matcher.terms[i].stem
+	}
+	return stems
+}
+
+//firstTokens - returns the first token of the keyword and each synonym for a token-aware search, or false if the search is not token-aware or a term has no tokens
+func (matcher wordMatcher) firstTokens() (tokens []string, ok bool) {
+	if matcher.stemmer != nil || matcher.mode == MatchSubstring {
+		return nil, false
+	}
+	for _, term := range matcher.terms {
+		if len(term.tokens) == 0 {
+			return nil, false
+		}
+		tokens = append(tokens, term.tokens[0].word)
+	}
+	return tokens, true
+}
+
+//term - returns the term a match came through: the synonym it was expanded from, or the keyword
+func (matcher wordMatcher) term(expandedFrom string) matchTerm {
+	for _, term := range matcher.terms[1:] {
+		if term.text == expandedFrom {
+			return term
+		}
+	}
+	return matcher.terms[0]
+}
+
+//highlights - returns the spans of the matched dictionary word to highlight
+func (matcher wordMatcher) highlights(dictionaryWord string, expandedFrom string) []HighlightSpan {
+	if matcher.stemmer == nil && matcher.mode != MatchSubstring {
+		return tokenHighlightSpans(dictionaryWord, matcher.term(expandedFrom).tokens, matcher.mode == MatchTokenPrefix)
+	}
+	return highlightSpans(dictionaryWord, matcher.highlightTerm(expandedFrom))
+}
+
+//matchPosition - returns the byte offset in the matched dictionary word at which the match starts. 0 when a stemmed match does not contain the stem
+func (matcher wordMatcher) matchPosition(dictionaryWord string, expandedFrom string) int {
+	if matcher.stemmer == nil && matcher.mode != MatchSubstring {
+		wordTokens := tokenizeText(dictionaryWord)
+		if start, ok := matchTokenRun(wordTokens, matcher.term(expandedFrom).tokens, matcher.mode == MatchTokenPrefix); ok && start < len(wordTokens) {
+			return wordTokens[start].byteStart
+		}
+		return 0
+	}
+	if position := strings.Index(dictionaryWord, matcher.highlightTerm(expandedFrom)); position > 0 {
+		return position
+	}
+	return 0
+}
+
+//highlightTerm - returns the text highlighted in a matched word: the keyword or the synonym the word matched through, or its stem for a stemmed search
+func (matcher wordMatcher) highlightTerm(expandedFrom string) string {
+	if matcher.stemmer != nil {
+		return matcher.stem(matcher.scoreTerm(expandedFrom))
+	}
+	return matcher.scoreTerm(expandedFrom)
+}
+
+//scoreTerm - returns the text a matched word is scored against: the keyword or the synonym the word matched through.
+// A stemmed search only uses the stem to find matches, so that the word searched for still ranks above the other forms of it
+func (matcher wordMatcher) scoreTerm(expandedFrom string) string {
+	if expandedFrom != "" {
+		return expandedFrom
+	}
+	return matcher.lowercaseKeyWord
+}
+
+//recordKeyWord - increment the wordStat numberOfTimesSearched property for a given search keyword,
+// and add the keyword to the user's search history and the search session's queries named by options
+func (wordDictionary *WordDictionary) recordKeyWord(lowercaseKeyWord string, options SearchOptions) {
+	if wordDictionary.keyWordStatsMap[lowercaseKeyWord] == nil {
+		keyWordStat := new(keyWordStat)
+		keyWordStat.word = lowercaseKeyWord
+		wordDictionary.keyWordStatsMap[lowercaseKeyWord] = keyWordStat
+		wordDictionary.keyWordStats = append(wordDictionary.keyWordStats, keyWordStat)
+	}
+	wordDictionary.keyWordStatsMap[lowercaseKeyWord].numberOfTimesSearched++
+
+	//Searching for a dictionary word makes it a more popular completion
+	if wordDictionary.dictionaryWords[lowercaseKeyWord] != nil {
+		wordDictionary.completions.set(lowercaseKeyWord, wordDictionary.keyWordStatsMap[lowercaseKeyWord].numberOfTimesSearched)
+	}
+
+	if options.UserID != "" {
+		wordDictionary.userHistories.record(options.UserID, lowercaseKeyWord)
+	}
+
+	if options.SessionID != "" {
+		wordDictionary.searchSessions.record(options.SessionID, options.UserID, lowercaseKeyWord, wordDictionary.now())
+	}
+}
+
+//AddWordsOptions - optional parameters for AddWordsWithOptions
+type AddWordsOptions struct {
+	//ExpiresAt - when set, the words are removed from the dictionary at this time
+	ExpiresAt time.Time
+	//TTL - when set, the words are removed from the dictionary this long after being added. Ignored if ExpiresAt is set
+	TTL time.Duration
+}
+
+//expiresAt - returns the time words added with these options expire, or the zero time if they do not expire
+func (options AddWordsOptions) expiresAt(now time.Time) time.Time {
+	if options.ExpiresAt.IsZero() && options.TTL > 0 {
+		return now.Add(options.TTL)
+	}
+	return options.ExpiresAt
+}
+
+//AddWords - add words to the list
+func (wordDictionary *WordDictionary) AddWords(words []string) (err error) {
+	_, err = wordDictionary.AddWordsWithOptions(words, AddWordsOptions{})
+	return err
+}
+
+//AddWordsWithOptions - add words to the list, as determined by options, returning the version of the dictionary the words were added in
+func (wordDictionary *WordDictionary) AddWordsWithOptions(words []string, options AddWordsOptions) (version int64, err error) {
+	wordDictionary.mutex.Lock()
+	defer wordDictionary.mutex.Unlock()
+
+	//Convert all words to lowercase before adding them
+	lowercaseWords := wordDictionary.wordsToLowercase(words)
+
+	//Validation... do all of the words satisfy the validation policy?
+	if violations := wordDictionary.validateWords(lowercaseWords); len(violations) > 0 {
+		return 0, &WordValidationError{Violations: violations}
+	}
+
+	//Validation... do any of the words exist already?
+	for i := range lowercaseWords {
+		word := lowercaseWords[i]
+		if wordDictionary.liveEntry(word) != nil {
+			return 0, errors.New(fmt.Sprintf("%s word already exists", word))
+		}
+	}
+
+	//Would these words take the dictionary, or its tenant, over its limit?
+	if err = wordDictionary.reserveRoomFor(wordDictionary.numberOfNewWords(lowercaseWords)); err != nil {
+		return 0, err
+	}
+
+	//Add each of these words to the words. An expired word which has not been swept yet is replaced
+	newWords := make(map[string]bool, len(lowercaseWords))
+	for i := range lowercaseWords {
+		newWords[lowercaseWords[i]] = true
+	}
+	expiresAt := options.expiresAt(wordDictionary.now())
+	changes := make([]wordChange, 0, len(newWords))
+	for i := range lowercaseWords {
+		word := lowercaseWords[i]
+		if newWords[word] {
+			changes = append(changes, wordChange{word: word, before: wordDictionary.dictionaryWords[word], after: newWordEntry(WordMetadata{}, expiresAt)})
+			delete(newWords, word)
+		}
+	}
+	return wordDictionary.commitChanges("add words", changes), nil
+}
+
+//checkRoomFor - returns ErrWordLimitExceeded if adding the lowercase words would take the dictionary over its limit, or its tenant over
+// the tenant word limit. Nothing is taken from the tenant word quota. The caller must hold the mutex
+func (wordDictionary *WordDictionary) checkRoomFor(lowercaseWords []string) error {
+	numberOfNewWords := wordDictionary.numberOfNewWords(lowercaseWords)
+	if !wordDictionary.hasRoomFor(numberOfNewWords) {
+		return wordDictionary.wordLimitExceeded()
+	}
+	return wordDictionary.wordQuota.checkRoomFor(numberOfNewWords)
+}
+
+//numberOfNewWords - returns the number of the lowercase words which are not in the dictionary.
+// Words repeated in lowercaseWords, or with an expired entry, are not counted twice. The caller must hold the mutex
+func (wordDictionary *WordDictionary) numberOfNewWords(lowercaseWords []string) int {
+	newWords := make(map[string]bool, len(lowercaseWords))
+	for i := range lowercaseWords {
+		if wordDictionary.dictionaryWords[lowercaseWords[i]] == nil {
+			newWords[lowercaseWords[i]] = true
+		}
+	}
+	return len(newWords)
+}
+
+//wordLimitExceeded - returns the error for words which would take the dictionary over its word limit
+func (wordDictionary *WordDictionary) wordLimitExceeded() error {
+	return errors.Wrap(ErrWordLimitExceeded, fmt.Sprintf("%s holds at most %d words", wordDictionary.name, wordDictionary.wordLimit))
+}
+
+//liveEntry - returns the entry for word, or nil if the word is not in the dictionary or has expired. The caller must hold the mutex
+func (wordDictionary *WordDictionary) liveEntry(word string) *wordEntry {
+	entry := wordDictionary.dictionaryWords[word]
+	if entry == nil || entry.expired(wordDictionary.now()) {
+		return nil
+	}
+	return entry
+}
+
+//wordsToLowercase - converts the []string to a lowercase []string, see normalizeWord
+func (wordDictionary *WordDictionary) wordsToLowercase(words []string) (lowercaseWords []string) {
+	_lowercaseWords := make([]string, len(words))
+	for i := range words {
+		_lowercaseWords[i] = normalizeWord(words[i])
+	}
+	return _lowercaseWords
+}
+
+//normalizeWord - converts a word or keyword to the form it is stored and matched in: lowercase and in Unicode normalization form C,
+// so that the same text typed with composed or decomposed characters is treated as the same word
+func normalizeWord(word string) string {
+	return norm.NFC.String(strings.ToLower(word))
+}
+
+//Top5SearchKeyWords - returns the top 5 most searched keywords
+func (wordDictionary *WordDictionary) Top5SearchKeyWords() (keyWords []string) {
+	wordDictionary.mutex.Lock()
+	defer wordDictionary.mutex.Unlock()
+
+	//Clone the source slice, leaving out blocked keywords. They are still counted so that they rank correctly if unblocked
+	keyWordStats := make([]*keyWordStat, 0, len(wordDictionary.keyWordStats))
+	for _, keyWordStat := range wordDictionary.keyWordStats {
+		if !wordDictionary.blocklist.IsBlocked(keyWordStat.word) {
+			keyWordStats = append(keyWordStats, keyWordStat)
+		}
+	}
+
+	//Sort alphabetically
+	_alphabeticalWordInfoSlice := alphabeticalKeyWordStatSlice(keyWordStats)
+	sort.Sort(_alphabeticalWordInfoSlice)
+
+	//Sort by search frequency
+	_searchFrequencyWordInfoSlice := searchFrequencyKeyWordStatSlice(_alphabeticalWordInfoSlice)
+	sort.Sort(_searchFrequencyWordInfoSlice)
+
+	//Get first 5 words or less of the sorted words
+	sliceMax := 5
+	if sliceMax > len(_searchFrequencyWordInfoSlice) {
+		sliceMax = len(_searchFrequencyWordInfoSlice)
+	}
+	top5WordInfoSlice := _searchFrequencyWordInfoSlice[:sliceMax]
+	top5Words := make([]string, len(top5WordInfoSlice))
+	for i := range top5WordInfoSlice {
+		top5Words[i] = top5WordInfoSlice[i].word
+	}
+
+	//return the result
+	return top5Words
+}
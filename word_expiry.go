@@ -0,0 +1,76 @@
+package main
+
+import (
+	"time"
+)
+
+//defaultExpirySweepInterval - how often expired words are removed when the config does not say
+const defaultExpirySweepInterval = time.Minute
+
+//RemoveExpiredWords - removes every expired word from the dictionary as a single version, returning the number of words removed.
+// Expired words are already hidden from searches, this releases the space they take up
+func (wordDictionary *WordDictionary) RemoveExpiredWords() (removed int) {
+	wordDictionary.mutex.Lock()
+	defer wordDictionary.mutex.Unlock()
+
+	now := wordDictionary.now()
+	changes := make([]wordChange, 0)
+	for word, entry := range wordDictionary.dictionaryWords {
+		if entry.expired(now) {
+			changes = append(changes, wordChange{word: word, before: entry})
+		}
+	}
+	if len(changes) > 0 {
+		wordDictionary.commitChanges("remove expired words", changes)
+	}
+	return len(changes)
+}
+
+//RemoveExpiredWords - removes every expired word from each of the dictionaries, returning the number of words removed
+func (wordSearchService *WordSearchService) RemoveExpiredWords() (removed int) {
+	for _, name := range wordSearchService.ListDictionaries() {
+		dictionary, err := wordSearchService.Dictionary(name)
+		if err != nil {
+			//The dictionary was deleted since it was listed
+			continue
+		}
+		removed += dictionary.RemoveExpiredWords()
+	}
+	return removed
+}
+
+//RemoveExpiredWords - removes every expired word from each tenant's dictionaries, returning the number of words removed
+func (tenantRegistry *TenantRegistry) RemoveExpiredWords() (removed int) {
+	tenantRegistry.mutex.Lock()
+	tenants := make([]*Tenant, 0, len(tenantRegistry.tenants))
+	for _, tenant := range tenantRegistry.tenants {
+		tenants = append(tenants, tenant)
+	}
+	tenantRegistry.mutex.Unlock()
+
+	for _, tenant := range tenants {
+		removed += tenant.WordSearchService().RemoveExpiredWords()
+	}
+	return removed
+}
+
+//StartExpirySweeper - removes expired words from every tenant's dictionaries every interval, until the returned stop function is called
+func StartExpirySweeper(tenantRegistry *TenantRegistry, interval time.Duration) (stop func()) {
+	if interval <= 0 {
+		interval = defaultExpirySweepInterval
+	}
+	ticker := time.NewTicker(interval)
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				tenantRegistry.RemoveExpiredWords()
+			case <-done:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+	return func() { close(done) }
+}
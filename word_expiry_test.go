@@ -0,0 +1,60 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWordDictionary_Expiry(t *testing.T) {
+	now := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	wordDictionary := NewWordDictionary("test")
+	wordDictionary.now = func() time.Time { return now }
+
+	wordDictionary.AddWords([]string{"summer"})
+	wordDictionary.AddWordsWithOptions([]string{"summersale"}, AddWordsOptions{TTL: time.Hour})
+	wordDictionary.UpsertWords([]WordEntry{{Word: "summerfest", ExpiresAt: now.Add(2 * time.Hour)}})
+
+	//it should return words which have not expired yet
+	assert.EqualValues(t, []string{"summer", "summerfest", "summersale"}, wordDictionary.SearchWord("summer"))
+
+	//it should hide expired words from searches and exports before they are swept
+	now = now.Add(time.Hour)
+	assert.EqualValues(t, []string{"summer", "summerfest"}, wordDictionary.SearchWord("summer"))
+	exportedWords := make([]string, 0)
+	for _, wordEntry := range wordDictionary.sortedDictionaryWordEntries() {
+		exportedWords = append(exportedWords, wordEntry.word)
+	}
+	assert.EqualValues(t, []string{"summer", "summerfest"}, exportedWords)
+
+	//it should allow an expired word to be added again
+	_, err := wordDictionary.AddWordsWithOptions([]string{"summersale"}, AddWordsOptions{TTL: time.Hour})
+	assert.NoError(t, err)
+	assert.EqualValues(t, []string{"summer", "summerfest", "summersale"}, wordDictionary.SearchWord("summer"))
+
+	//it should remove expired words from the dictionary when swept, as a new version
+	now = now.Add(time.Hour)
+	version := wordDictionary.Version()
+	assert.EqualValues(t, 2, wordDictionary.RemoveExpiredWords())
+	assert.EqualValues(t, version+1, wordDictionary.Version())
+	assert.Len(t, wordDictionary.dictionaryWords, 1)
+	assert.EqualValues(t, []string{"summer"}, wordDictionary.SearchWord("summer"))
+
+	//it should not produce a version when there is nothing to sweep
+	assert.EqualValues(t, 0, wordDictionary.RemoveExpiredWords())
+	assert.EqualValues(t, version+1, wordDictionary.Version())
+}
+
+func TestTenantRegistry_RemoveExpiredWords(t *testing.T) {
+	tenantRegistry := NewTenantRegistry(TenantQuota{}, nil)
+	now := time.Now()
+	for _, id := range []string{"acme", "globex"} {
+		tenant, _ := tenantRegistry.AddTenant(TenantConfig{ID: id})
+		dictionary, _ := tenant.WordSearchService().Dictionary("")
+		dictionary.AddWordsWithOptions([]string{"flash"}, AddWordsOptions{ExpiresAt: now.Add(-time.Second)})
+	}
+
+	//it should sweep the dictionaries of every tenant
+	assert.EqualValues(t, 2, tenantRegistry.RemoveExpiredWords())
+}
@@ -0,0 +1,221 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	wordsearchsystemgrpc "github.com/chrisjpalmer/word_search_system/word_search_system_grpc"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+)
+
+//ExportFormat - the encoding used when exporting the dictionary
+type ExportFormat string
+
+const (
+	//ExportFormatText - one word per line
+	ExportFormatText ExportFormat = "text"
+	//ExportFormatJSONL - one json object per line with the word's metadata and expiry e.g. {"word":"hello","partOfSpeech":"interjection"}
+	ExportFormatJSONL ExportFormat = "jsonl"
+	//ExportFormatCSV - a csv document with a header row, see exportCSVHeader. Tags are separated by ";" and attributes are a json object
+	ExportFormatCSV ExportFormat = "csv"
+)
+
+//defaultExportChunkSize - the number of words sent per chunk when the caller does not specify a chunk size
+const defaultExportChunkSize = 1000
+
+//exportCSVHeader - the header row of the csv export format
+var exportCSVHeader = []string{"word", "definition", "partOfSpeech", "tags", "attributes", "expiresAt"}
+
+//exportedWord - the json representation of a word in the jsonl export format
+type exportedWord struct {
+	Word         string            `json:"word"`
+	Definition   string            `json:"definition,omitempty"`
+	PartOfSpeech string            `json:"partOfSpeech,omitempty"`
+	Tags         []string          `json:"tags,omitempty"`
+	Attributes   map[string]string `json:"attributes,omitempty"`
+	ExpiresAt    *time.Time        `json:"expiresAt,omitempty"`
+}
+
+//dictionaryWordEntry - a dictionary word and its entry, as snapshotted for an export
+type dictionaryWordEntry struct {
+	word  string
+	entry *wordEntry
+}
+
+//newExportedWord - converts a dictionary word and its entry into its exported representation
+func newExportedWord(wordEntry dictionaryWordEntry) exportedWord {
+	metadata := wordEntry.entry.metadata
+	_exportedWord := exportedWord{
+		Word:         wordEntry.word,
+		Definition:   metadata.Definition,
+		PartOfSpeech: metadata.PartOfSpeech,
+		Tags:         metadata.Tags,
+		Attributes:   metadata.Attributes,
+	}
+	if !wordEntry.entry.expiresAt.IsZero() {
+		expiresAt := wordEntry.entry.expiresAt.UTC()
+		_exportedWord.ExpiresAt = &expiresAt
+	}
+	return _exportedWord
+}
+
+//ParseExportFormat - converts a format name into an ExportFormat, defaulting to text when the name is empty
+func ParseExportFormat(name string) (ExportFormat, error) {
+	switch ExportFormat(name) {
+	case "":
+		return ExportFormatText, nil
+	case ExportFormatText, ExportFormatJSONL, ExportFormatCSV:
+		return ExportFormat(name), nil
+	}
+	return "", errors.New(fmt.Sprintf("%s is not a supported export format", name))
+}
+
+//ExportWords - streams the dictionary in alphabetical order to send, chunkSize words at a time, encoded in the given format.
+// The dictionary is snapshotted before the first chunk is sent so that a slow consumer does not block other requests
+func (wordDictionary *WordDictionary) ExportWords(format ExportFormat, chunkSize int, send func(chunk []byte) error) (err error) {
+	if _, err = ParseExportFormat(string(format)); err != nil {
+		return err
+	}
+	if chunkSize <= 0 {
+		chunkSize = defaultExportChunkSize
+	}
+
+	//Snapshot the dictionary. Entries are never modified once stored, so they can be read without the lock
+	words := wordDictionary.sortedDictionaryWordEntries()
+
+	//Encode and send each chunk
+	for start := 0; start < len(words) || start == 0; start += chunkSize {
+		end := start + chunkSize
+		if end > len(words) {
+			end = len(words)
+		}
+		chunk, err := encodeExportChunk(format, words[start:end], start == 0)
+		if err != nil {
+			return err
+		}
+		if len(chunk) == 0 {
+			break
+		}
+		if err = send(chunk); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//sortedDictionaryWordEntries - returns all unexpired dictionary words and their entries in alphabetical order
+func (wordDictionary *WordDictionary) sortedDictionaryWordEntries() (words []dictionaryWordEntry) {
+	wordDictionary.mutex.Lock()
+	defer wordDictionary.mutex.Unlock()
+
+	now := wordDictionary.now()
+	_words := make([]dictionaryWordEntry, 0, len(wordDictionary.sortedWords))
+	for _, dictionaryWord := range wordDictionary.sortedWords {
+		if entry := wordDictionary.dictionaryWords[dictionaryWord]; !entry.expired(now) {
+			_words = append(_words, dictionaryWordEntry{word: dictionaryWord, entry: entry})
+		}
+	}
+	return _words
+}
+
+//encodeExportChunk - encodes a chunk of words. firstChunk is used to decide whether the csv header row should be written
+func encodeExportChunk(format ExportFormat, words []dictionaryWordEntry, firstChunk bool) (chunk []byte, err error) {
+	var buffer bytes.Buffer
+	switch format {
+	case ExportFormatJSONL:
+		for i := range words {
+			line, err := json.Marshal(newExportedWord(words[i]))
+			if err != nil {
+				return nil, err
+			}
+			buffer.Write(line)
+			buffer.WriteByte('\n')
+		}
+	case ExportFormatCSV:
+		csvWriter := csv.NewWriter(&buffer)
+		if firstChunk {
+			csvWriter.Write(exportCSVHeader)
+		}
+		for i := range words {
+			_exportedWord := newExportedWord(words[i])
+			attributes := ""
+			if len(_exportedWord.Attributes) > 0 {
+				encodedAttributes, err := json.Marshal(_exportedWord.Attributes)
+				if err != nil {
+					return nil, err
+				}
+				attributes = string(encodedAttributes)
+			}
+			expiresAt := ""
+			if _exportedWord.ExpiresAt != nil {
+				expiresAt = _exportedWord.ExpiresAt.Format(time.RFC3339Nano)
+			}
+			csvWriter.Write([]string{_exportedWord.Word, _exportedWord.Definition, _exportedWord.PartOfSpeech, strings.Join(_exportedWord.Tags, ";"), attributes, expiresAt})
+		}
+		csvWriter.Flush()
+		if err = csvWriter.Error(); err != nil {
+			return nil, err
+		}
+	default:
+		for i := range words {
+			buffer.WriteString(words[i].word)
+			buffer.WriteByte('\n')
+		}
+	}
+	return buffer.Bytes(), nil
+}
+
+//WithClientCredentials - returns a context which makes requests for the tenant with tenantID, authenticating with apiKey.
+// Either may be empty: without a tenant id requests are made for the tenant the API key belongs to, and without an API key for a tenant which has none
+func WithClientCredentials(ctx context.Context, tenantID string, apiKey string) context.Context {
+	md := metadata.MD{}
+	if tenantID != "" {
+		md.Set(tenantMetadataKey, tenantID)
+	}
+	if apiKey != "" {
+		md.Set(authorizationMetadataKey, "Bearer "+apiKey)
+	}
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+//DownloadWords - exports the dictionary named dictionaryName from the server client is connected to, writing it to writer encoded in format
+func DownloadWords(ctx context.Context, client wordsearchsystemgrpc.WordSearchSystemClient, dictionaryName string, format ExportFormat, writer io.Writer) error {
+	stream, err := client.ExportWords(ctx, &wordsearchsystemgrpc.ExportWordsRequest{Dictionary: dictionaryName, Format: string(format)})
+	if err != nil {
+		return errors.Wrap(err, "failed to export words")
+	}
+	for {
+		reply, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "failed to export words")
+		}
+		if _, err = writer.Write(reply.Chunk); err != nil {
+			return errors.Wrap(err, "failed to write exported words")
+		}
+	}
+}
+
+//DownloadWordsFile - exports the dictionary named dictionaryName from the server client is connected to into the file at path, see DownloadWords
+func DownloadWordsFile(ctx context.Context, client wordsearchsystemgrpc.WordSearchSystemClient, dictionaryName string, format ExportFormat, path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err = DownloadWords(ctx, client, dictionaryName, format, file); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWordDictionary_ExportWords(t *testing.T) {
+	//exportChunks - runs an export and collects every chunk that was sent
+	exportChunks := func(t *testing.T, format ExportFormat, chunkSize int) []string {
+		wordDictionary, _ := NewWordSearchService().Dictionary(DefaultDictionaryName)
+		chunks := make([]string, 0)
+		err := wordDictionary.ExportWords(format, chunkSize, func(chunk []byte) error {
+			chunks = append(chunks, string(chunk))
+			return nil
+		})
+		if err != nil {
+			t.Errorf("ExportWords() returned an error %+v", err)
+		}
+		return chunks
+	}
+
+	t.Run("text format", func(t *testing.T) {
+		//it should export the default words alphabetically, in chunks of the requested size
+		chunks := exportChunks(t, ExportFormatText, 3)
+		assert.EqualValues(t, []string{
+			"filter\ngoodbye\nhello\n",
+			"list\nno\nsearch\n",
+			"yes\n",
+		}, chunks)
+	})
+	t.Run("jsonl format", func(t *testing.T) {
+		chunks := exportChunks(t, ExportFormatJSONL, 5)
+		assert.EqualValues(t, []string{
+			"{\"word\":\"filter\"}\n{\"word\":\"goodbye\"}\n{\"word\":\"hello\"}\n{\"word\":\"list\"}\n{\"word\":\"no\"}\n",
+			"{\"word\":\"search\"}\n{\"word\":\"yes\"}\n",
+		}, chunks)
+	})
+	t.Run("csv format", func(t *testing.T) {
+		//it should only write the header row in the first chunk
+		chunks := exportChunks(t, ExportFormatCSV, 4)
+		assert.EqualValues(t, []string{
+			"word,definition,partOfSpeech,tags,attributes,expiresAt\nfilter,,,,,\ngoodbye,,,,,\nhello,,,,,\nlist,,,,,\n",
+			"no,,,,,\nsearch,,,,,\nyes,,,,,\n",
+		}, chunks)
+	})
+	t.Run("metadata and expiry", func(t *testing.T) {
+		wordDictionary := NewWordDictionary("test")
+		expiresAt := time.Date(2100, 1, 2, 3, 4, 5, 0, time.UTC)
+		wordDictionary.UpsertWords([]WordEntry{
+			{Word: "kiwi", Metadata: WordMetadata{Definition: "a fruit, furry", PartOfSpeech: "noun", Tags: []string{"food", "fruit"}, Attributes: map[string]string{"origin": "china"}}, ExpiresAt: expiresAt},
+			{Word: "lime"},
+		})
+		export := func(format ExportFormat) string {
+			var exported string
+			wordDictionary.ExportWords(format, 0, func(chunk []byte) error {
+				exported += string(chunk)
+				return nil
+			})
+			return exported
+		}
+
+		//it should export each word's metadata and expiry
+		assert.EqualValues(t, `{"word":"kiwi","definition":"a fruit, furry","partOfSpeech":"noun","tags":["food","fruit"],"attributes":{"origin":"china"},"expiresAt":"2100-01-02T03:04:05Z"}
+{"word":"lime"}
+`, export(ExportFormatJSONL))
+		assert.EqualValues(t, `word,definition,partOfSpeech,tags,attributes,expiresAt
+kiwi,"a fruit, furry",noun,food;fruit,"{""origin"":""china""}",2100-01-02T03:04:05Z
+lime,,,,,
+`, export(ExportFormatCSV))
+	})
+	t.Run("unsupported format", func(t *testing.T) {
+		wordDictionary, _ := NewWordSearchService().Dictionary(DefaultDictionaryName)
+		err := wordDictionary.ExportWords("xml", 0, func(chunk []byte) error { return nil })
+		assert.Error(t, err)
+	})
+	t.Run("export does not affect statistics", func(t *testing.T) {
+		wordDictionary, _ := NewWordSearchService().Dictionary(DefaultDictionaryName)
+		wordDictionary.ExportWords(ExportFormatText, 0, func(chunk []byte) error { return nil })
+		assert.EqualValues(t, []string{}, wordDictionary.Top5SearchKeyWords())
+	})
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+//HighlightSpan - a part of a matched word which matches the keyword. Start and End are rune offsets into the word, End is exclusive
+type HighlightSpan struct {
+	Start int
+	End   int
+}
+
+//highlightSpans - returns the spans of word which match the normalized keyword, in order and without overlapping.
+// word and keyword must already be normalized with normalizeWord, so the offsets are into the word as it is returned to the caller
+func highlightSpans(word string, lowercaseKeyWord string) []HighlightSpan {
+	spans := make([]HighlightSpan, 0)
+	if lowercaseKeyWord == "" {
+		return spans
+	}
+	keyWordRunes := utf8.RuneCountInString(lowercaseKeyWord)
+	byteOffset, runeOffset := 0, 0
+	for {
+		i := strings.Index(word[byteOffset:], lowercaseKeyWord)
+		if i < 0 {
+			return spans
+		}
+		runeOffset += utf8.RuneCountInString(word[byteOffset : byteOffset+i])
+		spans = append(spans, HighlightSpan{Start: runeOffset, End: runeOffset + keyWordRunes})
+		byteOffset += i + len(lowercaseKeyWord)
+		runeOffset += keyWordRunes
+	}
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHighlights(t *testing.T) {
+	t.Run("highlight spans test", func(t *testing.T) {
+		//it should return every non-overlapping occurrence of the keyword
+		assert.EqualValues(t, []HighlightSpan{{1, 3}, {3, 5}}, highlightSpans("banana", "an"))
+		assert.EqualValues(t, []HighlightSpan{{0, 2}, {2, 4}}, highlightSpans("aaaaa", "aa"))
+
+		//it should count offsets in runes rather than bytes
+		assert.EqualValues(t, []HighlightSpan{{6, 9}}, highlightSpans("crème brûlée", "brû"))
+
+		//it should return no spans for an empty keyword
+		assert.EqualValues(t, []HighlightSpan{}, highlightSpans("hello", ""))
+	})
+	t.Run("search highlights test", func(t *testing.T) {
+		wordDictionary := NewWordDictionary("test")
+		//"Café" with a decomposed é, "naïve" with a composed ï, searched for the other way around
+		wordDictionary.AddWords([]string{"Café", "naïve", "banana"})
+
+		//it should only return highlights when asked for
+		results, _ := wordDictionary.Search("an", SearchOptions{})
+		assert.Nil(t, results.Matches[0].Highlights)
+
+		//it should return the spans of each match
+		results, _ = wordDictionary.Search("AN", SearchOptions{IncludeHighlights: true})
+		assert.EqualValues(t, []HighlightSpan{{1, 3}, {3, 5}}, results.Matches[0].Highlights)
+
+		//it should match and highlight words whatever normalization form they were added and searched in
+		results, _ = wordDictionary.Search("CAF\u00c9", SearchOptions{IncludeHighlights: true})
+		assert.EqualValues(t, []string{"café"}, matchWords(results.Matches))
+		assert.EqualValues(t, []HighlightSpan{{0, 4}}, results.Matches[0].Highlights)
+		results, _ = wordDictionary.Search("ïv", SearchOptions{IncludeHighlights: true})
+		assert.EqualValues(t, []string{"naïve"}, matchWords(results.Matches))
+		assert.EqualValues(t, []HighlightSpan{{2, 4}}, results.Matches[0].Highlights)
+	})
+	t.Run("stream highlights test", func(t *testing.T) {
+		wordDictionary := NewWordDictionary("test")
+		wordDictionary.AddWords([]string{"banana"})
+
+		//it should return highlights from streaming searches too
+		var matches []SearchMatch
+		wordDictionary.StreamSearch(context.Background(), "na", SearchOptions{IncludeHighlights: true}, 0, func(batch []SearchMatch) error {
+			matches = append(matches, batch...)
+			return nil
+		})
+		assert.EqualValues(t, []HighlightSpan{{2, 4}, {4, 6}}, matches[0].Highlights)
+	})
+}
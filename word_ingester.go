@@ -0,0 +1,120 @@
+package main
+
+import (
+	"time"
+)
+
+//defaultIngestBatchSize - the number of words buffered before a batch is applied when the caller does not specify a batch size
+const defaultIngestBatchSize = 1000
+
+//AddWordsSummary - the outcome of a bulk ingestion
+type AddWordsSummary struct {
+	Added      int64
+	Duplicates int64
+	Rejected   int64
+}
+
+//WordIngester - accepts words in chunks (e.g. from a client stream) and adds them to a WordDictionary in batches.
+// Unlike AddWords, a duplicate or invalid word does not fail the ingestion, it is counted in the summary instead
+type WordIngester struct {
+	wordDictionary *WordDictionary
+	batchSize      int
+	pendingWords   []string
+	summary        AddWordsSummary
+	//versions - the dictionary versions produced by the batches applied so far
+	versions []int64
+}
+
+//NewWordIngester - creates a new WordIngester which adds words to wordDictionary batchSize words at a time
+func NewWordIngester(wordDictionary *WordDictionary, batchSize int) *WordIngester {
+	if batchSize <= 0 {
+		batchSize = defaultIngestBatchSize
+	}
+	newWordIngester := new(WordIngester)
+	newWordIngester.wordDictionary = wordDictionary
+	newWordIngester.batchSize = batchSize
+	newWordIngester.pendingWords = make([]string, 0, batchSize)
+	return newWordIngester
+}
+
+//Add - buffers a chunk of words, applying full batches as they become available
+func (wordIngester *WordIngester) Add(words []string) {
+	for i := range words {
+		wordIngester.pendingWords = append(wordIngester.pendingWords, words[i])
+		if len(wordIngester.pendingWords) >= wordIngester.batchSize {
+			wordIngester.flush()
+		}
+	}
+}
+
+//Close - applies any remaining buffered words and returns the summary of the whole ingestion
+func (wordIngester *WordIngester) Close() AddWordsSummary {
+	wordIngester.flush()
+	return wordIngester.summary
+}
+
+//flush - applies the buffered words to the wordDictionary
+func (wordIngester *WordIngester) flush() {
+	if len(wordIngester.pendingWords) == 0 {
+		return
+	}
+	batchSummary, version := wordIngester.wordDictionary.addWordsBatch(wordIngester.pendingWords)
+	if version != 0 {
+		wordIngester.versions = append(wordIngester.versions, version)
+	}
+	wordIngester.summary.Added += batchSummary.Added
+	wordIngester.summary.Duplicates += batchSummary.Duplicates
+	wordIngester.summary.Rejected += batchSummary.Rejected
+	wordIngester.pendingWords = wordIngester.pendingWords[:0]
+}
+
+//AddWordsFromText - adds the distinct words of text, as found by TokenizeText with options, to the dictionary.
+// Like a WordIngester, words which already exist, break the validation policy or do not fit within the word limit are counted in the summary rather than failing.
+// version is the dictionary version the words were added in, or 0 if none were added
+func (wordDictionary *WordDictionary) AddWordsFromText(text string, options TokenizeOptions) (summary AddWordsSummary, version int64) {
+	textWords := TokenizeText(text, options)
+	words := make([]string, len(textWords))
+	for i := range textWords {
+		words[i] = textWords[i].Word
+	}
+	return wordDictionary.addWordsBatch(words)
+}
+
+//addWordsBatch - adds every acceptable word in words, counting words which already exist, break the validation policy or do not fit within the word limit rather than failing.
+// version is the dictionary version the batch produced, or 0 if it added nothing
+func (wordDictionary *WordDictionary) addWordsBatch(words []string) (summary AddWordsSummary, version int64) {
+	wordDictionary.mutex.Lock()
+	defer wordDictionary.mutex.Unlock()
+
+	lowercaseWords := wordDictionary.wordsToLowercase(words)
+	batchWords := make(map[string]bool, len(lowercaseWords))
+	changes := make([]wordChange, 0, len(lowercaseWords))
+	//numberOfNewWords - the words of the batch which are not in the dictionary. A word replacing an expired entry is already counted
+	numberOfNewWords := 0
+	for i := range lowercaseWords {
+		word := lowercaseWords[i]
+		if wordDictionary.validateWord(word) != "" {
+			summary.Rejected++
+			continue
+		}
+		if wordDictionary.liveEntry(word) != nil || batchWords[word] {
+			summary.Duplicates++
+			continue
+		}
+		isNew := wordDictionary.dictionaryWords[word] == nil
+		if isNew && (!wordDictionary.hasRoomFor(numberOfNewWords+1) || wordDictionary.wordQuota.reserve(1) != nil) {
+			summary.Rejected++
+			continue
+		}
+		if isNew {
+			numberOfNewWords++
+		}
+		batchWords[word] = true
+		changes = append(changes, wordChange{word: word, before: wordDictionary.dictionaryWords[word], after: newWordEntry(WordMetadata{}, time.Time{})})
+		summary.Added++
+	}
+	if len(changes) > 0 {
+		version = wordDictionary.commitChanges("add words batch", changes)
+	}
+	return summary, version
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWordIngester(t *testing.T) {
+	t.Run("basic test", func(t *testing.T) {
+		wordDictionary, _ := NewWordSearchService().Dictionary(DefaultDictionaryName)
+		wordIngester := NewWordIngester(wordDictionary, 3)
+
+		//it should accept words over several chunks
+		wordIngester.Add([]string{"apple", "banana", "cherry"})
+		wordIngester.Add([]string{"date"})
+
+		//it should have applied the full batches before Close() is called, but not the partial one
+		assert.EqualValues(t, []string{"cherry"}, wordDictionary.SearchWord("cherry"))
+		assert.EqualValues(t, []string{}, wordDictionary.SearchWord("date"))
+
+		//it should apply the remaining words when closed
+		summary := wordIngester.Close()
+		assert.EqualValues(t, AddWordsSummary{Added: 4}, summary)
+		assert.EqualValues(t, []string{"date"}, wordDictionary.SearchWord("date"))
+	})
+	t.Run("duplicate and rejected words", func(t *testing.T) {
+		wordDictionary, _ := NewWordSearchService().Dictionary(DefaultDictionaryName)
+		wordIngester := NewWordIngester(wordDictionary, 0)
+
+		//it should count words which already exist, repeat within the stream, or are blank without failing the ingestion
+		wordIngester.Add([]string{"Hello", "walk", "WALK", "", "  ", "run"})
+		summary := wordIngester.Close()
+		assert.EqualValues(t, AddWordsSummary{Added: 2, Duplicates: 2, Rejected: 2}, summary)
+		assert.EqualValues(t, []string{"walk"}, wordDictionary.SearchWord("walk"))
+		assert.EqualValues(t, []string{"run"}, wordDictionary.SearchWord("run"))
+	})
+	t.Run("word limit", func(t *testing.T) {
+		now := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+		wordDictionary := NewWordDictionary("test")
+		wordDictionary.now = func() time.Time { return now }
+		wordDictionary.AddWords([]string{"apple"})
+		wordDictionary.AddWordsWithOptions([]string{"flash"}, AddWordsOptions{TTL: time.Minute})
+		wordDictionary.SetWordLimit(3)
+		now = now.Add(time.Hour)
+
+		//it should not count a word replacing an expired entry against the limit, as the entry already counts
+		summary, _ := wordDictionary.addWordsBatch([]string{"flash", "kiwi", "lime"})
+		assert.EqualValues(t, AddWordsSummary{Added: 2, Rejected: 1}, summary)
+		assert.EqualValues(t, []string{"flash"}, wordDictionary.SearchWord("flash"))
+		assert.EqualValues(t, []string{"kiwi"}, wordDictionary.SearchWord("kiwi"))
+	})
+}
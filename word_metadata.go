@@ -0,0 +1,146 @@
+package main
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+//WordMetadata - structured information held against a dictionary word
+type WordMetadata struct {
+	Definition   string
+	PartOfSpeech string
+	Tags         []string
+	Attributes   map[string]string
+}
+
+//WordEntry - a word together with its metadata, as passed to UpsertWords
+type WordEntry struct {
+	Word     string
+	Metadata WordMetadata
+	//ExpiresAt and TTL - when the word is removed from the dictionary, as described by AddWordsOptions
+	ExpiresAt time.Time
+	TTL       time.Duration
+}
+
+//wordEntry - the value stored against each dictionary word.
+// Entries are never modified once stored, a change replaces the entry, so that earlier versions of the dictionary can keep referring to them
+type wordEntry struct {
+	metadata  WordMetadata
+	expiresAt time.Time
+}
+
+//newWordEntry - creates a wordEntry holding a normalized copy of metadata, which expires at expiresAt unless it is the zero time
+func newWordEntry(metadata WordMetadata, expiresAt time.Time) *wordEntry {
+	newWordEntry := new(wordEntry)
+	newWordEntry.expiresAt = expiresAt
+	newWordEntry.metadata.Definition = metadata.Definition
+	newWordEntry.metadata.PartOfSpeech = strings.ToLower(strings.TrimSpace(metadata.PartOfSpeech))
+	newWordEntry.metadata.Tags = normalizeTags(metadata.Tags)
+	if len(metadata.Attributes) > 0 {
+		newWordEntry.metadata.Attributes = make(map[string]string, len(metadata.Attributes))
+		for key, value := range metadata.Attributes {
+			newWordEntry.metadata.Attributes[key] = value
+		}
+	}
+	return newWordEntry
+}
+
+//expired - reports whether the entry has expired by now
+func (entry *wordEntry) expired(now time.Time) bool {
+	return !entry.expiresAt.IsZero() && !now.Before(entry.expiresAt)
+}
+
+//clone - returns a copy of the metadata which the caller is free to modify
+func (wordMetadata WordMetadata) clone() *WordMetadata {
+	return &newWordEntry(wordMetadata, time.Time{}).metadata
+}
+
+//normalizeTags - lowercases, de-duplicates and sorts tags, dropping blank ones
+func normalizeTags(tags []string) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+	seenTags := make(map[string]bool, len(tags))
+	_tags := make([]string, 0, len(tags))
+	for i := range tags {
+		tag := strings.ToLower(strings.TrimSpace(tags[i]))
+		if tag != "" && !seenTags[tag] {
+			seenTags[tag] = true
+			_tags = append(_tags, tag)
+		}
+	}
+	sort.Strings(_tags)
+	return _tags
+}
+
+//UpsertWords - adds each word with its metadata, or replaces the metadata of words which already exist. All of the entries are applied as a single version.
+// If any word breaks the validation policy none are applied, and the reason for each such word is returned. version is the dictionary version the entries produced
+func (wordDictionary *WordDictionary) UpsertWords(entries []WordEntry) (version int64, err error) {
+	wordDictionary.mutex.Lock()
+	defer wordDictionary.mutex.Unlock()
+
+	//Later entries for the same word take precedence over earlier ones
+	changesByWord := make(map[string]int, len(entries))
+	changes := make([]wordChange, 0, len(entries))
+	numberOfNewWords := 0
+	violations := make([]WordViolation, 0)
+	now := wordDictionary.now()
+	for i := range entries {
+		expiresAt := AddWordsOptions{ExpiresAt: entries[i].ExpiresAt, TTL: entries[i].TTL}.expiresAt(now)
+		word := normalizeWord(entries[i].Word)
+		if reason := wordDictionary.validateWord(word); reason != "" {
+			violations = append(violations, WordViolation{Index: i, Word: word, Reason: reason})
+			continue
+		}
+		if j, ok := changesByWord[word]; ok {
+			changes[j].after = newWordEntry(entries[i].Metadata, expiresAt)
+			continue
+		}
+		before := wordDictionary.dictionaryWords[word]
+		if before == nil {
+			numberOfNewWords++
+		}
+		changesByWord[word] = len(changes)
+		changes = append(changes, wordChange{word: word, before: before, after: newWordEntry(entries[i].Metadata, expiresAt)})
+	}
+
+	if len(violations) > 0 {
+		return 0, &WordValidationError{Violations: violations}
+	}
+
+	//Would the new words take the dictionary, or its tenant, over its limit?
+	if err = wordDictionary.reserveRoomFor(numberOfNewWords); err != nil {
+		return 0, err
+	}
+
+	return wordDictionary.commitChanges("upsert words", changes), nil
+}
+
+//wordMetadataFilter - restricts search matches to words with a part of speech and a set of tags
+type wordMetadataFilter struct {
+	partOfSpeech string
+	tags         []string
+}
+
+//newWordMetadataFilter - creates a wordMetadataFilter. An empty partOfSpeech or tags does not restrict the matches
+func newWordMetadataFilter(partOfSpeech string, tags []string) wordMetadataFilter {
+	return wordMetadataFilter{
+		partOfSpeech: strings.ToLower(strings.TrimSpace(partOfSpeech)),
+		tags:         normalizeTags(tags),
+	}
+}
+
+//matches - reports whether metadata passes the filter
+func (filter wordMetadataFilter) matches(metadata WordMetadata) bool {
+	if filter.partOfSpeech != "" && filter.partOfSpeech != metadata.PartOfSpeech {
+		return false
+	}
+	for _, tag := range filter.tags {
+		i := sort.SearchStrings(metadata.Tags, tag)
+		if i == len(metadata.Tags) || metadata.Tags[i] != tag {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWordDictionary_UpsertWords(t *testing.T) {
+	t.Run("basic test", func(t *testing.T) {
+		wordDictionary := NewWordDictionary("test")
+		wordDictionary.AddWords([]string{"bank"})
+
+		//it should add new words and update the metadata of existing words in a single version
+		_, err := wordDictionary.UpsertWords([]WordEntry{
+			{Word: "Bank", Metadata: WordMetadata{Definition: "a financial institution", PartOfSpeech: "Noun", Tags: []string{"Finance", "finance"}}},
+			{Word: "bankrupt", Metadata: WordMetadata{PartOfSpeech: "adjective", Attributes: map[string]string{"register": "formal"}}},
+		})
+		assert.NoError(t, err)
+		assert.EqualValues(t, 2, wordDictionary.Version())
+		versions := wordDictionary.ListVersions()
+		assert.EqualValues(t, 1, versions[1].WordsAdded)
+		assert.EqualValues(t, 1, versions[1].WordsUpdated)
+
+		//it should return normalized metadata when asked for
+		searchResults, _ := wordDictionary.Search("bank", SearchOptions{IncludeMetadata: true})
+		assert.EqualValues(t, []SearchMatch{
+			{Word: "bank", Metadata: &WordMetadata{Definition: "a financial institution", PartOfSpeech: "noun", Tags: []string{"finance"}}},
+			{Word: "bankrupt", Metadata: &WordMetadata{PartOfSpeech: "adjective", Attributes: map[string]string{"register": "formal"}}},
+		}, searchResults.Matches)
+
+		//it should not return metadata unless asked for
+		searchResults, _ = wordDictionary.Search("bank", SearchOptions{})
+		assert.Nil(t, searchResults.Matches[0].Metadata)
+
+		//it should refuse entries without a word
+		_, err = wordDictionary.UpsertWords([]WordEntry{{Word: " "}})
+		assert.Error(t, err)
+	})
+	t.Run("metadata filters", func(t *testing.T) {
+		wordDictionary := NewWordDictionary("test")
+		wordDictionary.UpsertWords([]WordEntry{
+			{Word: "bond", Metadata: WordMetadata{PartOfSpeech: "noun", Tags: []string{"finance"}}},
+			{Word: "bonus", Metadata: WordMetadata{PartOfSpeech: "noun", Tags: []string{"finance", "hr"}}},
+			{Word: "bonding", Metadata: WordMetadata{PartOfSpeech: "verb", Tags: []string{"finance"}}},
+			{Word: "bonnet", Metadata: WordMetadata{PartOfSpeech: "noun"}},
+		})
+
+		//it should only return nouns tagged finance
+		assert.EqualValues(t, []string{"bond", "bonus"}, searchWords(t, wordDictionary, "bon", SearchOptions{PartOfSpeech: "noun", Tags: []string{"finance"}}))
+
+		//it should require every tag
+		assert.EqualValues(t, []string{"bonus"}, searchWords(t, wordDictionary, "bon", SearchOptions{Tags: []string{"Finance", "HR"}}))
+	})
+	t.Run("rollback restores metadata", func(t *testing.T) {
+		wordDictionary := NewWordDictionary("test")
+		wordDictionary.UpsertWords([]WordEntry{{Word: "bond", Metadata: WordMetadata{Definition: "a promise"}}})
+		wordDictionary.UpsertWords([]WordEntry{{Word: "bond", Metadata: WordMetadata{Definition: "a debt security"}}})
+
+		//it should restore the metadata the word had at the earlier version
+		wordDictionary.RollbackDictionary(1)
+		searchResults, _ := wordDictionary.Search("bond", SearchOptions{IncludeMetadata: true})
+		assert.EqualValues(t, "a promise", searchResults.Matches[0].Metadata.Definition)
+	})
+}
@@ -0,0 +1,461 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+//
+//QUERY LANGUAGE
+//
+//A query is made of terms combined with AND, OR, NOT and parentheses. NOT binds tightest, then AND, then OR.
+// Terms written next to each other without an operator are ANDed together.
+//
+//Terms:
+//  cat       - words containing "cat", as SearchWord matches
+//  pre*      - words matching the pattern, where * stands for any run of characters. The pattern must match the whole word
+//  "cat"     - the word "cat" exactly
+//  len:3-5   - words of 3 to 5 characters. len:3, len:3- and len:-5 are also accepted
+//  pos:noun  - words whose part of speech is noun
+//  tag:pets  - words tagged pets
+//
+//Examples: pre* AND NOT *fix, (cat OR dog) len:3-5
+//
+
+//QuerySyntaxError - returned when a query cannot be parsed. Column is the 1-based character position of the problem
+type QuerySyntaxError struct {
+	Column  int
+	Message string
+}
+
+func (querySyntaxError *QuerySyntaxError) Error() string {
+	return fmt.Sprintf("query syntax error at column %d: %s", querySyntaxError.Column, querySyntaxError.Message)
+}
+
+//QueryNode - a node of a parsed query
+type QueryNode interface {
+	//String - returns the node in the query language, fully parenthesized
+	String() string
+	//matches - reports whether the dictionary word with entry satisfies the node
+	matches(word string, entry *wordEntry) bool
+	//prefix - returns a prefix every word satisfying the node starts with, or "" if there is none
+	prefix() string
+}
+
+//QueryAnd - satisfied when both sides are
+type QueryAnd struct {
+	Left  QueryNode
+	Right QueryNode
+}
+
+//QueryOr - satisfied when either side is
+type QueryOr struct {
+	Left  QueryNode
+	Right QueryNode
+}
+
+//QueryNot - satisfied when the operand is not
+type QueryNot struct {
+	Operand QueryNode
+}
+
+//QueryTerm - matches words containing Text, or matching it as a pattern when it contains *
+type QueryTerm struct {
+	Text string
+}
+
+//QueryExact - matches the word Text exactly
+type QueryExact struct {
+	Text string
+}
+
+//QueryLength - matches words of Min to Max characters. A Max of 0 means there is no upper limit
+type QueryLength struct {
+	Min int
+	Max int
+}
+
+//QueryPartOfSpeech - matches words with the part of speech
+type QueryPartOfSpeech struct {
+	PartOfSpeech string
+}
+
+//QueryTag - matches words with the tag
+type QueryTag struct {
+	Tag string
+}
+
+func (node *QueryAnd) String() string {
+	return "(" + node.Left.String() + " AND " + node.Right.String() + ")"
+}
+func (node *QueryAnd) matches(word string, entry *wordEntry) bool {
+	return node.Left.matches(word, entry) && node.Right.matches(word, entry)
+}
+func (node *QueryAnd) prefix() string {
+	//Both prefixes must hold, so the longer one narrows the search the most
+	left, right := node.Left.prefix(), node.Right.prefix()
+	if len(right) > len(left) {
+		return right
+	}
+	return left
+}
+
+func (node *QueryOr) String() string {
+	return "(" + node.Left.String() + " OR " + node.Right.String() + ")"
+}
+func (node *QueryOr) matches(word string, entry *wordEntry) bool {
+	return node.Left.matches(word, entry) || node.Right.matches(word, entry)
+}
+func (node *QueryOr) prefix() string {
+	//Either prefix may hold, so only what they have in common is certain
+	left, right := node.Left.prefix(), node.Right.prefix()
+	i := 0
+	for i < len(left) && i < len(right) && left[i] == right[i] {
+		i++
+	}
+	return left[:i]
+}
+
+func (node *QueryNot) String() string {
+	return "(NOT " + node.Operand.String() + ")"
+}
+func (node *QueryNot) matches(word string, entry *wordEntry) bool {
+	return !node.Operand.matches(word, entry)
+}
+func (node *QueryNot) prefix() string {
+	return ""
+}
+
+func (node *QueryTerm) String() string {
+	return node.Text
+}
+func (node *QueryTerm) matches(word string, entry *wordEntry) bool {
+	if !strings.Contains(node.Text, "*") {
+		return strings.Contains(word, node.Text)
+	}
+	return matchWildcardPattern(word, strings.Split(node.Text, "*"))
+}
+func (node *QueryTerm) prefix() string {
+	if i := strings.Index(node.Text, "*"); i >= 0 {
+		return node.Text[:i]
+	}
+	return ""
+}
+
+func (node *QueryExact) String() string {
+	return strconv.Quote(node.Text)
+}
+func (node *QueryExact) matches(word string, entry *wordEntry) bool {
+	return word == node.Text
+}
+func (node *QueryExact) prefix() string {
+	return node.Text
+}
+
+func (node *QueryLength) String() string {
+	if node.Max == 0 {
+		return fmt.Sprintf("len:%d-", node.Min)
+	}
+	return fmt.Sprintf("len:%d-%d", node.Min, node.Max)
+}
+func (node *QueryLength) matches(word string, entry *wordEntry) bool {
+	length := utf8.RuneCountInString(word)
+	return length >= node.Min && (node.Max == 0 || length <= node.Max)
+}
+func (node *QueryLength) prefix() string {
+	return ""
+}
+
+func (node *QueryPartOfSpeech) String() string {
+	return "pos:" + node.PartOfSpeech
+}
+func (node *QueryPartOfSpeech) matches(word string, entry *wordEntry) bool {
+	return entry.metadata.PartOfSpeech == node.PartOfSpeech
+}
+func (node *QueryPartOfSpeech) prefix() string {
+	return ""
+}
+
+func (node *QueryTag) String() string {
+	return "tag:" + node.Tag
+}
+func (node *QueryTag) matches(word string, entry *wordEntry) bool {
+	i := sort.SearchStrings(entry.metadata.Tags, node.Tag)
+	return i < len(entry.metadata.Tags) && entry.metadata.Tags[i] == node.Tag
+}
+func (node *QueryTag) prefix() string {
+	return ""
+}
+
+//matchWildcardPattern - reports whether word matches the pattern which was split around each *
+func matchWildcardPattern(word string, parts []string) bool {
+	if !strings.HasPrefix(word, parts[0]) {
+		return false
+	}
+	word = word[len(parts[0]):]
+	last := parts[len(parts)-1]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(word, part)
+		if i < 0 {
+			return false
+		}
+		word = word[i+len(part):]
+	}
+	return len(word) >= len(last) && strings.HasSuffix(word, last)
+}
+
+//
+//PARSER
+//
+
+//queryTokenKind - the kinds of token a query is made of
+type queryTokenKind int
+
+const (
+	queryTokenEnd queryTokenKind = iota
+	queryTokenWord
+	queryTokenQuoted
+	queryTokenOpen
+	queryTokenClose
+)
+
+//queryToken - a token of a query, along with the 1-based column it starts at
+type queryToken struct {
+	kind   queryTokenKind
+	text   string
+	column int
+}
+
+//is - reports whether the token is the operator keyword
+func (token queryToken) is(keyword string) bool {
+	return token.kind == queryTokenWord && token.text == keyword
+}
+
+//tokenizeQuery - splits a query into tokens, ending with a queryTokenEnd token
+func tokenizeQuery(query string) ([]queryToken, error) {
+	runes := []rune(query)
+	tokens := make([]queryToken, 0)
+	for i := 0; i < len(runes); {
+		switch {
+		case unicode.IsSpace(runes[i]):
+			i++
+		case runes[i] == '(':
+			tokens = append(tokens, queryToken{kind: queryTokenOpen, text: "(", column: i + 1})
+			i++
+		case runes[i] == ')':
+			tokens = append(tokens, queryToken{kind: queryTokenClose, text: ")", column: i + 1})
+			i++
+		case runes[i] == '"':
+			end := i + 1
+			for end < len(runes) && runes[end] != '"' {
+				end++
+			}
+			if end == len(runes) {
+				return nil, &QuerySyntaxError{Column: i + 1, Message: "unterminated quoted word"}
+			}
+			tokens = append(tokens, queryToken{kind: queryTokenQuoted, text: string(runes[i+1 : end]), column: i + 1})
+			i = end + 1
+		default:
+			end := i
+			for end < len(runes) && !unicode.IsSpace(runes[end]) && runes[end] != '(' && runes[end] != ')' && runes[end] != '"' {
+				end++
+			}
+			tokens = append(tokens, queryToken{kind: queryTokenWord, text: string(runes[i:end]), column: i + 1})
+			i = end
+		}
+	}
+	return append(tokens, queryToken{kind: queryTokenEnd, column: len(runes) + 1}), nil
+}
+
+//queryParser - a recursive descent parser over the tokens of a query
+type queryParser struct {
+	tokens   []queryToken
+	position int
+}
+
+//ParseQuery - parses a query into its syntax tree. A *QuerySyntaxError is returned if the query is not valid
+func ParseQuery(query string) (QueryNode, error) {
+	tokens, err := tokenizeQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	parser := &queryParser{tokens: tokens}
+	node, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if token := parser.peek(); token.kind != queryTokenEnd {
+		return nil, &QuerySyntaxError{Column: token.column, Message: fmt.Sprintf("unexpected %q", token.text)}
+	}
+	return node, nil
+}
+
+func (parser *queryParser) peek() queryToken {
+	return parser.tokens[parser.position]
+}
+
+func (parser *queryParser) next() queryToken {
+	token := parser.tokens[parser.position]
+	parser.position++
+	return token
+}
+
+//parseOr - or := and (OR and)*
+func (parser *queryParser) parseOr() (QueryNode, error) {
+	node, err := parser.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for parser.peek().is("OR") {
+		parser.next()
+		right, err := parser.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		node = &QueryOr{Left: node, Right: right}
+	}
+	return node, nil
+}
+
+//parseAnd - and := unary ([AND] unary)*
+func (parser *queryParser) parseAnd() (QueryNode, error) {
+	node, err := parser.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		token := parser.peek()
+		if token.is("AND") {
+			parser.next()
+		} else if token.kind == queryTokenEnd || token.kind == queryTokenClose || token.is("OR") {
+			return node, nil
+		}
+		right, err := parser.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		node = &QueryAnd{Left: node, Right: right}
+	}
+}
+
+//parseUnary - unary := NOT unary | ( or ) | term
+func (parser *queryParser) parseUnary() (QueryNode, error) {
+	token := parser.next()
+	switch {
+	case token.is("NOT"):
+		operand, err := parser.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &QueryNot{Operand: operand}, nil
+	case token.kind == queryTokenOpen:
+		node, err := parser.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := parser.next(); closing.kind != queryTokenClose {
+			return nil, &QuerySyntaxError{Column: closing.column, Message: fmt.Sprintf("expected ) to close the ( at column %d", token.column)}
+		}
+		return node, nil
+	case token.kind == queryTokenQuoted:
+		return &QueryExact{Text: normalizeWord(token.text)}, nil
+	case token.kind == queryTokenWord && !token.is("AND") && !token.is("OR"):
+		return parseQueryTerm(token)
+	case token.kind == queryTokenEnd:
+		return nil, &QuerySyntaxError{Column: token.column, Message: "expected a term at the end of the query"}
+	default:
+		return nil, &QuerySyntaxError{Column: token.column, Message: fmt.Sprintf("expected a term but found %q", token.text)}
+	}
+}
+
+//parseQueryTerm - parses a word token, which is either a field filter or a term
+func parseQueryTerm(token queryToken) (QueryNode, error) {
+	i := strings.Index(token.text, ":")
+	if i < 0 {
+		return &QueryTerm{Text: normalizeWord(token.text)}, nil
+	}
+	field, value := strings.ToLower(token.text[:i]), token.text[i+1:]
+	valueColumn := token.column + utf8.RuneCountInString(token.text[:i+1])
+	if value == "" {
+		return nil, &QuerySyntaxError{Column: valueColumn, Message: fmt.Sprintf("expected a value for %s:", field)}
+	}
+	switch field {
+	case "len":
+		return parseQueryLength(value, valueColumn)
+	case "pos":
+		return &QueryPartOfSpeech{PartOfSpeech: strings.ToLower(value)}, nil
+	case "tag":
+		return &QueryTag{Tag: strings.ToLower(value)}, nil
+	default:
+		return nil, &QuerySyntaxError{Column: token.column, Message: fmt.Sprintf("unknown field %q", field)}
+	}
+}
+
+//parseQueryLength - parses the value of a len: filter, one of N, N-M, N- or -M
+func parseQueryLength(value string, column int) (QueryNode, error) {
+	invalid := &QuerySyntaxError{Column: column, Message: fmt.Sprintf("invalid length %q, expected N, N-M, N- or -M", value)}
+	bounds := strings.SplitN(value, "-", 2)
+	node := &QueryLength{}
+	var err error
+	if bounds[0] != "" {
+		if node.Min, err = strconv.Atoi(bounds[0]); err != nil || node.Min < 0 {
+			return nil, invalid
+		}
+	}
+	if len(bounds) == 1 {
+		node.Max = node.Min
+		if node.Max == 0 {
+			return nil, invalid
+		}
+		return node, nil
+	}
+	if bounds[1] != "" {
+		if node.Max, err = strconv.Atoi(bounds[1]); err != nil || node.Max < 1 || node.Max < node.Min {
+			return nil, invalid
+		}
+	}
+	return node, nil
+}
+
+//
+//EVALUATOR
+//
+
+//QueryWords - returns the words matching the query in alphabetical order. A *QuerySyntaxError is returned if the query is not valid.
+// When every match must start with a known prefix, only that range of the sorted word index is scanned
+func (wordDictionary *WordDictionary) QueryWords(query string) (matches []string, err error) {
+	node, err := ParseQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	wordDictionary.mutex.Lock()
+	defer wordDictionary.mutex.Unlock()
+
+	prefix := node.prefix()
+	now := wordDictionary.now()
+	matches = make([]string, 0)
+	for i := sort.SearchStrings(wordDictionary.sortedWords, prefix); i < len(wordDictionary.sortedWords); i++ {
+		word := wordDictionary.sortedWords[i]
+		if !strings.HasPrefix(word, prefix) {
+			break
+		}
+		entry := wordDictionary.dictionaryWords[word]
+		if entry.expired(now) || wordDictionary.blocklist.IsBlocked(word) {
+			continue
+		}
+		if node.matches(word, entry) {
+			matches = append(matches, word)
+		}
+	}
+	return matches, nil
+}
+
+//QueryWords - returns the words of the default dictionary matching the query
+func (wordSearchService *WordSearchService) QueryWords(query string) (matches []string, err error) {
+	return wordSearchService.defaultDictionary().QueryWords(query)
+}
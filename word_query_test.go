@@ -0,0 +1,78 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseQuery(t *testing.T) {
+	t.Run("precedence test", func(t *testing.T) {
+		//it should bind NOT tighter than AND, and AND tighter than OR
+		node, err := ParseQuery("a OR b AND NOT c")
+		assert.NoError(t, err)
+		assert.EqualValues(t, "(a OR (b AND (NOT c)))", node.String())
+
+		//it should AND together terms written without an operator
+		node, _ = ParseQuery("(cat OR dog) len:3-5")
+		assert.EqualValues(t, "((cat OR dog) AND len:3-5)", node.String())
+
+		//it should parse exact words, fields and patterns
+		node, _ = ParseQuery(`"Cat" pos:Noun tag:pets pre* len:4`)
+		assert.EqualValues(t, `(((("cat" AND pos:noun) AND tag:pets) AND pre*) AND len:4-4)`, node.String())
+	})
+	t.Run("syntax error test", func(t *testing.T) {
+		tests := []struct {
+			query  string
+			column int
+		}{
+			{"", 1},
+			{"cat AND", 8},
+			{"(cat OR dog", 12},
+			{"cat)", 4},
+			{"cat OR OR dog", 8},
+			{`cat "dog`, 5},
+			{"len:5-3", 5},
+			{"colour:red", 1},
+			{"pos:", 5},
+		}
+		for _, test := range tests {
+			//it should report the column of the problem
+			_, err := ParseQuery(test.query)
+			if assert.IsType(t, &QuerySyntaxError{}, err, test.query) {
+				assert.EqualValues(t, test.column, err.(*QuerySyntaxError).Column, test.query)
+			}
+		}
+	})
+}
+
+func TestWordDictionary_QueryWords(t *testing.T) {
+	wordDictionary := NewWordDictionary("test")
+	wordDictionary.AddWords([]string{"prefix", "prepare", "suffix", "present", "cat", "dog", "horse", "catalog", "doghouse"})
+	wordDictionary.UpsertWords([]WordEntry{{Word: "kitten", Metadata: WordMetadata{PartOfSpeech: "noun", Tags: []string{"pets"}}}})
+
+	tests := []struct {
+		query   string
+		matches []string
+	}{
+		{"pre* AND NOT *fix", []string{"prepare", "present"}},
+		{"*fix", []string{"prefix", "suffix"}},
+		{"(cat OR dog) len:3-5", []string{"cat", "dog"}},
+		{"cat", []string{"cat", "catalog"}},
+		{`"cat"`, []string{"cat"}},
+		{"p*e*t", []string{"present"}},
+		{"len:7-", []string{"catalog", "doghouse", "prepare", "present"}},
+		{"pos:noun OR tag:pets", []string{"kitten"}},
+		{"NOT (c* OR d* OR p* OR s* OR h*)", []string{"kitten"}},
+	}
+	for _, test := range tests {
+		//it should return the matching words in alphabetical order
+		matches, err := wordDictionary.QueryWords(test.query)
+		assert.NoError(t, err, test.query)
+		assert.EqualValues(t, test.matches, matches, test.query)
+	}
+
+	//it should return syntax errors
+	_, err := wordDictionary.QueryWords("cat AND")
+	assert.IsType(t, &QuerySyntaxError{}, err)
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+//wordQuota - the number of words a tenant may hold across all of its dictionaries and documents. It is shared by each of them,
+// which take words from it as they are added and give them back as they are removed. A nil wordQuota is unlimited
+type wordQuota struct {
+	mutex sync.Mutex
+	//limit - the most words which may be held. A limit of 0 means the words are counted but not limited
+	limit int
+	used  int
+}
+
+//newWordQuota - creates a new wordQuota, with no words used, which allows limit words
+func newWordQuota(limit int) *wordQuota {
+	newWordQuota := new(wordQuota)
+	newWordQuota.limit = limit
+	return newWordQuota
+}
+
+//setLimit - changes the most words which may be held. Words already held are kept, even if there are now more than the limit
+func (quota *wordQuota) setLimit(limit int) {
+	quota.mutex.Lock()
+	defer quota.mutex.Unlock()
+
+	quota.limit = limit
+}
+
+//checkRoomFor - returns ErrWordLimitExceeded if numberOfWords more words could not be taken, without taking them
+func (quota *wordQuota) checkRoomFor(numberOfWords int) error {
+	if quota == nil || numberOfWords <= 0 {
+		return nil
+	}
+	quota.mutex.Lock()
+	defer quota.mutex.Unlock()
+
+	if quota.limit > 0 && quota.used+numberOfWords > quota.limit {
+		return quota.exceeded()
+	}
+	return nil
+}
+
+//reserve - takes numberOfWords words, returning ErrWordLimitExceeded and taking none if there is not room for them
+func (quota *wordQuota) reserve(numberOfWords int) error {
+	if quota == nil || numberOfWords <= 0 {
+		return nil
+	}
+	quota.mutex.Lock()
+	defer quota.mutex.Unlock()
+
+	if quota.limit > 0 && quota.used+numberOfWords > quota.limit {
+		return quota.exceeded()
+	}
+	quota.used += numberOfWords
+	return nil
+}
+
+//count - takes numberOfWords words which are already held, even if they are more than the limit
+func (quota *wordQuota) count(numberOfWords int) {
+	if quota == nil {
+		return
+	}
+	quota.mutex.Lock()
+	defer quota.mutex.Unlock()
+
+	quota.used += numberOfWords
+}
+
+//release - gives back numberOfWords words which were taken
+func (quota *wordQuota) release(numberOfWords int) {
+	if numberOfWords > 0 {
+		quota.count(-numberOfWords)
+	}
+}
+
+//exceeded - returns the error for words which do not fit within the quota. The caller must hold the mutex
+func (quota *wordQuota) exceeded() error {
+	return errors.Wrap(ErrWordLimitExceeded, fmt.Sprintf("the tenant holds at most %d words across its dictionaries and documents", quota.limit))
+}
+
+//setWordQuota - sets the tenant word quota the dictionary's words are taken from. The words the dictionary already holds are given back to
+// its previous quota and taken from the new one. A nil quota means only the dictionary's own word limit applies
+func (wordDictionary *WordDictionary) setWordQuota(quota *wordQuota) {
+	wordDictionary.mutex.Lock()
+	defer wordDictionary.mutex.Unlock()
+
+	wordDictionary.wordQuota.release(len(wordDictionary.dictionaryWords))
+	quota.count(len(wordDictionary.dictionaryWords))
+	wordDictionary.wordQuota = quota
+}
+
+//reserveRoomFor - returns ErrWordLimitExceeded if numberOfNewWords more words would take the dictionary over its word limit, or its tenant
+// over the tenant word limit. Otherwise the words are taken from the tenant word quota. The caller must hold the mutex
+func (wordDictionary *WordDictionary) reserveRoomFor(numberOfNewWords int) error {
+	if !wordDictionary.hasRoomFor(numberOfNewWords) {
+		return wordDictionary.wordLimitExceeded()
+	}
+	return wordDictionary.wordQuota.reserve(numberOfNewWords)
+}
+
+//setWordQuota - sets the tenant word quota the terms of the indexed documents are taken from
+func (documentIndex *DocumentIndex) setWordQuota(quota *wordQuota) {
+	documentIndex.mutex.Lock()
+	defer documentIndex.mutex.Unlock()
+
+	documentIndex.wordQuota.release(documentIndex.totalLength)
+	quota.count(documentIndex.totalLength)
+	documentIndex.wordQuota = quota
+}
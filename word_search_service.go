@@ -4,59 +4,58 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"sync"
 
 	"github.com/pkg/errors"
 )
 
-//
-//HELPER STRUCTURES
-//
-
-//keyWordStat - represents a word and any of its associated metadata
-type keyWordStat struct {
-	word                  string
-	numberOfTimesSearched int64
-}
-
-//keyWordStatSlice - a slice of keyWordStat struct
-type keyWordStatSlice []*keyWordStat
+//DefaultDictionaryName - the name of the dictionary used when a request does not name one. It always exists and cannot be deleted
+const DefaultDictionaryName = "default"
 
-//alphabeticalKeyWordStatSlice - a keyWordStatSlice that can be passed to sort.Sort() to sort words that are alphabetically higher to the start of the slice
-type alphabeticalKeyWordStatSlice keyWordStatSlice
+//ErrDictionaryNotFound - returned when a request names a dictionary which does not exist
+var ErrDictionaryNotFound = errors.New("dictionary not found")
 
-func (p alphabeticalKeyWordStatSlice) Len() int { return len(p) }
-func (p alphabeticalKeyWordStatSlice) Less(i, j int) bool {
-	return p[i].word < p[j].word
-}
-func (p alphabeticalKeyWordStatSlice) Swap(i, j int) { p[i], p[j] = p[j], p[i] }
+//ErrDictionaryExists - returned when creating a dictionary whose name is already taken
+var ErrDictionaryExists = errors.New("dictionary already exists")
 
-//searchFrequencyKeyWordStatSlice - a keyWordStatSlice that can be passed to sort.Sort() to sort words that are more frequently searched to the start of the slice
-type searchFrequencyKeyWordStatSlice keyWordStatSlice
-
-func (p searchFrequencyKeyWordStatSlice) Len() int { return len(p) }
-func (p searchFrequencyKeyWordStatSlice) Less(i, j int) bool {
-	return p[i].numberOfTimesSearched > p[j].numberOfTimesSearched
-}
-func (p searchFrequencyKeyWordStatSlice) Swap(i, j int) { p[i], p[j] = p[j], p[i] }
+//ErrDictionaryLimitExceeded - returned when creating a dictionary would take the service over its dictionary limit
+var ErrDictionaryLimitExceeded = errors.New("dictionary limit exceeded")
 
 //
 //WordSearchService
 //
 
-//WordSearchService - a service which allows words to be added and searched, as well as providing statistics on those words
+//WordSearchService - a service which holds named dictionaries, each of which allows words to be added and searched, as well as providing statistics on those words
 type WordSearchService struct {
-	dictionaryWords map[string]bool
-	keyWordStatsMap map[string]*keyWordStat
-	keyWordStats    []*keyWordStat
+	mutex        sync.RWMutex
+	dictionaries map[string]*WordDictionary
+	wordLimit    int
+	//wordQuota - the words the service may hold across all of its dictionaries and documents
+	wordQuota *wordQuota
+	//dictionaryLimit - the most dictionaries the service may hold, including the default dictionary. A limit of 0 means unlimited
+	dictionaryLimit         int
+	wordValidator           *WordValidator
+	blocklist               *Blocklist
+	synonyms                *SynonymGroups
+	documents               *DocumentIndex
+	autocompleteBlendWeight float64
 }
 
 //NewWordSearchService creates a new instance of WordSearchService
 func NewWordSearchService() *WordSearchService {
 	newWordSearchService := new(WordSearchService)
-	newWordSearchService.dictionaryWords = make(map[string]bool)
-	newWordSearchService.keyWordStatsMap = make(map[string]*keyWordStat)
-	newWordSearchService.keyWordStats = make([]*keyWordStat, 0, 0)
-	newWordSearchService.AddWords([]string{
+	newWordSearchService.dictionaries = make(map[string]*WordDictionary)
+	newWordSearchService.blocklist = NewBlocklist()
+	newWordSearchService.synonyms = NewSynonymGroups()
+	newWordSearchService.wordQuota = newWordQuota(0)
+	newWordSearchService.documents = NewDocumentIndex()
+	newWordSearchService.documents.setWordQuota(newWordSearchService.wordQuota)
+	newWordSearchService.autocompleteBlendWeight = DefaultAutocompleteBlendWeight
+	defaultDictionary := NewWordDictionary(DefaultDictionaryName)
+	defaultDictionary.setWordQuota(newWordSearchService.wordQuota)
+	defaultDictionary.SetBlocklist(newWordSearchService.blocklist)
+	defaultDictionary.SetSynonyms(newWordSearchService.synonyms)
+	defaultDictionary.AddWords([]string{
 		"hello",
 		"goodbye",
 		"list",
@@ -65,97 +64,155 @@ func NewWordSearchService() *WordSearchService {
 		"yes",
 		"no",
 	})
+	newWordSearchService.dictionaries[DefaultDictionaryName] = defaultDictionary
 	return newWordSearchService
 }
 
-//SearchWord - returns possible matches for the keyword provided
-func (wordSearchService *WordSearchService) SearchWord(keyWord string) (matches []string) {
-	//convert the keyword to lowercase
-	lowercaseKeyWord := strings.ToLower(keyWord)
-
-	//record the the key word as being searches
-	wordSearchService.recordKeyWord(lowercaseKeyWord)
-
-	//Check if the word does not exist
-	possibleMatches := make([]string, 0, len(wordSearchService.dictionaryWords))
-	for dictionaryWord := range wordSearchService.dictionaryWords {
-		if strings.Contains(dictionaryWord, lowercaseKeyWord) {
-			possibleMatches = append(possibleMatches, dictionaryWord)
-		}
+//Dictionary - returns the dictionary with the given name. An empty name refers to the default dictionary
+func (wordSearchService *WordSearchService) Dictionary(name string) (*WordDictionary, error) {
+	wordSearchService.mutex.RLock()
+	defer wordSearchService.mutex.RUnlock()
+
+	dictionary := wordSearchService.dictionaries[wordSearchService.dictionaryName(name)]
+	if dictionary == nil {
+		return nil, errors.Wrap(ErrDictionaryNotFound, name)
 	}
+	return dictionary, nil
+}
 
-	//Order the matches alphabetically
-	sort.Strings(possibleMatches)
+//CreateDictionary - creates a new, empty dictionary
+func (wordSearchService *WordSearchService) CreateDictionary(name string) (*WordDictionary, error) {
+	wordSearchService.mutex.Lock()
+	defer wordSearchService.mutex.Unlock()
 
-	return possibleMatches
+	name = wordSearchService.dictionaryName(name)
+	if strings.TrimSpace(name) != name {
+		return nil, errors.New(fmt.Sprintf("%q is not a valid dictionary name", name))
+	}
+	if wordSearchService.dictionaries[name] != nil {
+		return nil, errors.Wrap(ErrDictionaryExists, name)
+	}
+	if wordSearchService.dictionaryLimit > 0 && len(wordSearchService.dictionaries) >= wordSearchService.dictionaryLimit {
+		return nil, errors.Wrap(ErrDictionaryLimitExceeded, fmt.Sprintf("at most %d dictionaries may be created", wordSearchService.dictionaryLimit))
+	}
+	dictionary := NewWordDictionary(name)
+	dictionary.SetWordLimit(wordSearchService.wordLimit)
+	dictionary.setWordQuota(wordSearchService.wordQuota)
+	dictionary.SetWordValidator(wordSearchService.wordValidator)
+	dictionary.SetBlocklist(wordSearchService.blocklist)
+	dictionary.SetSynonyms(wordSearchService.synonyms)
+	dictionary.SetAutocompleteBlendWeight(wordSearchService.autocompleteBlendWeight)
+	wordSearchService.dictionaries[name] = dictionary
+	return dictionary, nil
 }
 
-//recordKeyWord - increment the wordStat numberOfTimesSearched property for a given search keyword
-func (wordSearchService *WordSearchService) recordKeyWord(lowercaseKeyWord string) {
-	if wordSearchService.keyWordStatsMap[lowercaseKeyWord] == nil {
-		keyWordStat := new(keyWordStat)
-		keyWordStat.word = lowercaseKeyWord
-		wordSearchService.keyWordStatsMap[lowercaseKeyWord] = keyWordStat
-		wordSearchService.keyWordStats = append(wordSearchService.keyWordStats, keyWordStat)
+//DeleteDictionary - deletes a dictionary along with its words and keyword statistics. Its words are given back to the tenant word quota
+func (wordSearchService *WordSearchService) DeleteDictionary(name string) error {
+	wordSearchService.mutex.Lock()
+	defer wordSearchService.mutex.Unlock()
+
+	name = wordSearchService.dictionaryName(name)
+	if name == DefaultDictionaryName {
+		return errors.New("the default dictionary cannot be deleted")
+	}
+	dictionary := wordSearchService.dictionaries[name]
+	if dictionary == nil {
+		return errors.Wrap(ErrDictionaryNotFound, name)
 	}
-	wordSearchService.keyWordStatsMap[lowercaseKeyWord].numberOfTimesSearched++
+	delete(wordSearchService.dictionaries, name)
+	dictionary.setWordQuota(nil)
+	return nil
 }
 
-//AddWords - add words to the list
-func (wordSearchService *WordSearchService) AddWords(words []string) (err error) {
-	//Convert all words to lowercase before adding them
-	lowercaseWords := wordSearchService.wordsToLowercase(words)
-
-	//Validation... do any of the words exist already?
-	for i := range lowercaseWords {
-		word := lowercaseWords[i]
-		if wordSearchService.dictionaryWords[word] == true {
-			return errors.New(fmt.Sprintf("%s word already exists", word))
-		}
-	}
+//SetWordLimit - limits the number of words each dictionary may hold, including dictionaries created later. A limit of 0 means unlimited
+func (wordSearchService *WordSearchService) SetWordLimit(wordLimit int) {
+	wordSearchService.mutex.Lock()
+	defer wordSearchService.mutex.Unlock()
 
-	//Add each of these words to the words
-	for i := range lowercaseWords {
-		word := lowercaseWords[i]
-		wordSearchService.dictionaryWords[word] = true
+	wordSearchService.wordLimit = wordLimit
+	for _, dictionary := range wordSearchService.dictionaries {
+		dictionary.SetWordLimit(wordLimit)
 	}
+}
 
-	return nil
+//SetTenantWordLimit - limits the number of words the service may hold across all of its dictionaries and documents, where each term of a
+// document counts as a word. A limit of 0 means unlimited
+func (wordSearchService *WordSearchService) SetTenantWordLimit(wordLimit int) {
+	wordSearchService.wordQuota.setLimit(wordLimit)
 }
 
-//wordsToLowercase - converts the []string to a lowercase []string
-func (wordSearchService *WordSearchService) wordsToLowercase(words []string) (lowercaseWords []string) {
-	_lowercaseWords := make([]string, len(words))
-	for i := range words {
-		_lowercaseWords[i] = strings.ToLower(words[i])
+//SetDictionaryLimit - limits the number of dictionaries the service may hold, including the default dictionary. A limit of 0 means unlimited
+func (wordSearchService *WordSearchService) SetDictionaryLimit(dictionaryLimit int) {
+	wordSearchService.mutex.Lock()
+	defer wordSearchService.mutex.Unlock()
+
+	wordSearchService.dictionaryLimit = dictionaryLimit
+}
+
+//SetWordValidator - sets the policy words must satisfy to be added to each dictionary, including dictionaries created later
+func (wordSearchService *WordSearchService) SetWordValidator(wordValidator *WordValidator) {
+	wordSearchService.mutex.Lock()
+	defer wordSearchService.mutex.Unlock()
+
+	wordSearchService.wordValidator = wordValidator
+	for _, dictionary := range wordSearchService.dictionaries {
+		dictionary.SetWordValidator(wordValidator)
 	}
-	return _lowercaseWords
 }
 
-//Top5SearchKeyWords - returns the top 5 most searched keywords
-func (wordSearchService *WordSearchService) Top5SearchKeyWords() (keyWords []string) {
-	//Clone the source slice
-	keyWordStats := append(wordSearchService.keyWordStats[:0:0], wordSearchService.keyWordStats...)
+//Blocklist - returns the blocklist shared by all of the dictionaries
+func (wordSearchService *WordSearchService) Blocklist() *Blocklist {
+	return wordSearchService.blocklist
+}
 
-	//Sort alphabetically
-	_alphabeticalWordInfoSlice := alphabeticalKeyWordStatSlice(keyWordStats)
-	sort.Sort(_alphabeticalWordInfoSlice)
+//Synonyms - returns the synonym groups shared by all of the dictionaries
+func (wordSearchService *WordSearchService) Synonyms() *SynonymGroups {
+	return wordSearchService.synonyms
+}
+
+//Documents - returns the full-text index of the service's documents
+func (wordSearchService *WordSearchService) Documents() *DocumentIndex {
+	return wordSearchService.documents
+}
 
-	//Sort by search frequency
-	_searchFrequencyWordInfoSlice := searchFrequencyKeyWordStatSlice(_alphabeticalWordInfoSlice)
-	sort.Sort(_searchFrequencyWordInfoSlice)
+//ListDictionaries - returns the names of all dictionaries in alphabetical order
+func (wordSearchService *WordSearchService) ListDictionaries() (names []string) {
+	wordSearchService.mutex.RLock()
+	defer wordSearchService.mutex.RUnlock()
 
-	//Get first 5 words or less of the sorted words
-	sliceMax := 5
-	if sliceMax > len(_searchFrequencyWordInfoSlice) {
-		sliceMax = len(_searchFrequencyWordInfoSlice)
+	_names := make([]string, 0, len(wordSearchService.dictionaries))
+	for name := range wordSearchService.dictionaries {
+		_names = append(_names, name)
 	}
-	top5WordInfoSlice := _searchFrequencyWordInfoSlice[:sliceMax]
-	top5Words := make([]string, len(top5WordInfoSlice))
-	for i := range top5WordInfoSlice {
-		top5Words[i] = top5WordInfoSlice[i].word
+	sort.Strings(_names)
+	return _names
+}
+
+//dictionaryName - resolves an empty dictionary name to the default dictionary
+func (wordSearchService *WordSearchService) dictionaryName(name string) string {
+	if name == "" {
+		return DefaultDictionaryName
 	}
+	return name
+}
+
+//defaultDictionary - returns the default dictionary, which always exists
+func (wordSearchService *WordSearchService) defaultDictionary() *WordDictionary {
+	dictionary, _ := wordSearchService.Dictionary(DefaultDictionaryName)
+	return dictionary
+}
+
+//SearchWord - returns possible matches for the keyword provided from the default dictionary
+func (wordSearchService *WordSearchService) SearchWord(keyWord string) (matches []string) {
+	return wordSearchService.defaultDictionary().SearchWord(keyWord)
+}
 
-	//return the result
-	return top5Words
+//AddWords - add words to the default dictionary
+func (wordSearchService *WordSearchService) AddWords(words []string) (err error) {
+	return wordSearchService.defaultDictionary().AddWords(words)
+}
+
+//Top5SearchKeyWords - returns the top 5 most searched keywords of the default dictionary
+func (wordSearchService *WordSearchService) Top5SearchKeyWords() (keyWords []string) {
+	return wordSearchService.defaultDictionary().Top5SearchKeyWords()
 }
@@ -108,3 +108,51 @@ func TestWordSearchService_Top5SearchKeyWords(t *testing.T) {
 	top5SearchKeyWords = wordSearchService.Top5SearchKeyWords()
 	assert.EqualValues(t, []string{"bannana", "apple", "blueberry", "orange", "plum"}, top5SearchKeyWords)
 }
+
+func TestWordSearchService_Dictionaries(t *testing.T) {
+	t.Run("basic test", func(t *testing.T) {
+		wordSearchService := NewWordSearchService()
+
+		//it should only have the default dictionary when first created
+		assert.EqualValues(t, []string{DefaultDictionaryName}, wordSearchService.ListDictionaries())
+
+		//it should allow new dictionaries to be created and listed
+		_, err := wordSearchService.CreateDictionary("french")
+		if err != nil {
+			t.Errorf("CreateDictionary() returned an error %+v", err)
+		}
+		assert.EqualValues(t, []string{DefaultDictionaryName, "french"}, wordSearchService.ListDictionaries())
+
+		//it should not allow a dictionary to be created twice
+		_, err = wordSearchService.CreateDictionary("french")
+		assert.Error(t, err)
+
+		//it should allow dictionaries to be deleted, except for the default dictionary
+		assert.NoError(t, wordSearchService.DeleteDictionary("french"))
+		assert.Error(t, wordSearchService.DeleteDictionary("french"))
+		assert.Error(t, wordSearchService.DeleteDictionary(DefaultDictionaryName))
+		assert.EqualValues(t, []string{DefaultDictionaryName}, wordSearchService.ListDictionaries())
+
+		//it should treat an empty name as the default dictionary
+		dictionary, err := wordSearchService.Dictionary("")
+		if assert.NoError(t, err) {
+			assert.EqualValues(t, DefaultDictionaryName, dictionary.Name())
+		}
+	})
+	t.Run("isolation test", func(t *testing.T) {
+		wordSearchService := NewWordSearchService()
+		french, _ := wordSearchService.CreateDictionary("french")
+
+		//it should start new dictionaries empty
+		assert.EqualValues(t, []string{}, french.SearchWord("hello"))
+
+		//it should keep words separate between dictionaries
+		french.AddWords([]string{"bonjour"})
+		assert.EqualValues(t, []string{"bonjour"}, french.SearchWord("bon"))
+		assert.EqualValues(t, []string{}, wordSearchService.SearchWord("bon"))
+
+		//it should keep keyword statistics separate between dictionaries
+		assert.EqualValues(t, []string{"bon", "hello"}, french.Top5SearchKeyWords())
+		assert.EqualValues(t, []string{"bon"}, wordSearchService.Top5SearchKeyWords())
+	})
+}
@@ -0,0 +1,5388 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: word_search_system_grpc.proto
+
+package wordsearchsystemgrpc
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	grpc "google.golang.org/grpc"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type MatchMode int32
+
+const (
+	// Words containing the keyword anywhere, e.g. "rea" finds "ice cream"
+	MatchMode_MATCH_SUBSTRING MatchMode = 0
+	// Words whose tokens start with the keyword's tokens, the last keyword token being a prefix, e.g. "ice cr" finds "ice cream"
+	MatchMode_MATCH_PHRASE_PREFIX MatchMode = 1
+	// As MATCH_PHRASE_PREFIX, but the keyword's tokens may start at any token of the word, e.g. "cre" finds "ice cream"
+	MatchMode_MATCH_TOKEN_PREFIX MatchMode = 2
+)
+
+var MatchMode_name = map[int32]string{
+	0: "MATCH_SUBSTRING",
+	1: "MATCH_PHRASE_PREFIX",
+	2: "MATCH_TOKEN_PREFIX",
+}
+
+var MatchMode_value = map[string]int32{
+	"MATCH_SUBSTRING":     0,
+	"MATCH_PHRASE_PREFIX": 1,
+	"MATCH_TOKEN_PREFIX":  2,
+}
+
+func (x MatchMode) String() string {
+	return proto.EnumName(MatchMode_name, int32(x))
+}
+
+func (MatchMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{0}
+}
+
+// The request message containing the user's name.
+type SearchWordRequest struct {
+	KeyWord string `protobuf:"bytes,1,opt,name=keyWord,proto3" json:"keyWord,omitempty"`
+	// The dictionary to search. Defaults to the default dictionary, as do the dictionary fields of the other requests
+	Dictionary string `protobuf:"bytes,2,opt,name=dictionary,proto3" json:"dictionary,omitempty"`
+	// When not 0, the dictionary is searched as it was at this version
+	Version int64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// When true, each result carries the metadata of its word
+	IncludeMetadata bool `protobuf:"varint,4,opt,name=includeMetadata,proto3" json:"includeMetadata,omitempty"`
+	// When set, only words with this part of speech match
+	PartOfSpeech string `protobuf:"bytes,5,opt,name=partOfSpeech,proto3" json:"partOfSpeech,omitempty"`
+	// When set, only words carrying every one of these tags match
+	Tags []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	// The most matches to return, at most 1000. When 0 every match is returned
+	PageSize int32 `protobuf:"varint,7,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	// Empty for the first page, and the nextPageToken of the previous reply for the following pages
+	PageToken string `protobuf:"bytes,8,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	// When true, matches are ranked by relevance rather than alphabetically, and each result carries its score
+	RankByRelevance bool `protobuf:"varint,9,opt,name=rankByRelevance,proto3" json:"rankByRelevance,omitempty"`
+	// When true, each result carries the spans of its word which match the keyword
+	IncludeHighlights bool `protobuf:"varint,10,opt,name=includeHighlights,proto3" json:"includeHighlights,omitempty"`
+	// When true, words sharing the keyword's stem also match, so "running" finds "run" and "runs"
+	Stemming bool `protobuf:"varint,11,opt,name=stemming,proto3" json:"stemming,omitempty"`
+	// When true, the keyword's synonyms also match, and each result says which synonym it matched through
+	ExpandSynonyms bool `protobuf:"varint,12,opt,name=expandSynonyms,proto3" json:"expandSynonyms,omitempty"`
+	// How the keyword is matched against the words. Ignored when stemming
+	MatchMode            MatchMode `protobuf:"varint,13,opt,name=matchMode,proto3,enum=wordsearchsystemgrpc.MatchMode" json:"matchMode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *SearchWordRequest) Reset()         { *m = SearchWordRequest{} }
+func (m *SearchWordRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchWordRequest) ProtoMessage()    {}
+func (*SearchWordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{0}
+}
+
+func (m *SearchWordRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchWordRequest.Unmarshal(m, b)
+}
+func (m *SearchWordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchWordRequest.Marshal(b, m, deterministic)
+}
+func (m *SearchWordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchWordRequest.Merge(m, src)
+}
+func (m *SearchWordRequest) XXX_Size() int {
+	return xxx_messageInfo_SearchWordRequest.Size(m)
+}
+func (m *SearchWordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchWordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchWordRequest proto.InternalMessageInfo
+
+func (m *SearchWordRequest) GetKeyWord() string {
+	if m != nil {
+		return m.KeyWord
+	}
+	return ""
+}
+
+func (m *SearchWordRequest) GetDictionary() string {
+	if m != nil {
+		return m.Dictionary
+	}
+	return ""
+}
+
+func (m *SearchWordRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SearchWordRequest) GetIncludeMetadata() bool {
+	if m != nil {
+		return m.IncludeMetadata
+	}
+	return false
+}
+
+func (m *SearchWordRequest) GetPartOfSpeech() string {
+	if m != nil {
+		return m.PartOfSpeech
+	}
+	return ""
+}
+
+func (m *SearchWordRequest) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *SearchWordRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *SearchWordRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *SearchWordRequest) GetRankByRelevance() bool {
+	if m != nil {
+		return m.RankByRelevance
+	}
+	return false
+}
+
+func (m *SearchWordRequest) GetIncludeHighlights() bool {
+	if m != nil {
+		return m.IncludeHighlights
+	}
+	return false
+}
+
+func (m *SearchWordRequest) GetStemming() bool {
+	if m != nil {
+		return m.Stemming
+	}
+	return false
+}
+
+func (m *SearchWordRequest) GetExpandSynonyms() bool {
+	if m != nil {
+		return m.ExpandSynonyms
+	}
+	return false
+}
+
+func (m *SearchWordRequest) GetMatchMode() MatchMode {
+	if m != nil {
+		return m.MatchMode
+	}
+	return MatchMode_MATCH_SUBSTRING
+}
+
+// The response message containing the greetings
+type SearchWordReply struct {
+	Matches []string `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	// The dictionary version which was searched
+	Version int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// The matches along with what the request asked to know about them, in the same order as matches
+	Results []*SearchMatch `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	// Empty on the last page
+	NextPageToken string `protobuf:"bytes,4,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	// The number of matches across all pages
+	TotalMatches         int32    `protobuf:"varint,5,opt,name=totalMatches,proto3" json:"totalMatches,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchWordReply) Reset()         { *m = SearchWordReply{} }
+func (m *SearchWordReply) String() string { return proto.CompactTextString(m) }
+func (*SearchWordReply) ProtoMessage()    {}
+func (*SearchWordReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{1}
+}
+
+func (m *SearchWordReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchWordReply.Unmarshal(m, b)
+}
+func (m *SearchWordReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchWordReply.Marshal(b, m, deterministic)
+}
+func (m *SearchWordReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchWordReply.Merge(m, src)
+}
+func (m *SearchWordReply) XXX_Size() int {
+	return xxx_messageInfo_SearchWordReply.Size(m)
+}
+func (m *SearchWordReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchWordReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchWordReply proto.InternalMessageInfo
+
+func (m *SearchWordReply) GetMatches() []string {
+	if m != nil {
+		return m.Matches
+	}
+	return nil
+}
+
+func (m *SearchWordReply) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SearchWordReply) GetResults() []*SearchMatch {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *SearchWordReply) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *SearchWordReply) GetTotalMatches() int32 {
+	if m != nil {
+		return m.TotalMatches
+	}
+	return 0
+}
+
+type SearchMatch struct {
+	Word     string        `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	Metadata *WordMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// The relevance of the word to the search. Only set when ranking by relevance
+	Score float64 `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	// The spans of the word which match the keyword. Only set when includeHighlights is requested
+	Highlights []*HighlightSpan `protobuf:"bytes,4,rep,name=highlights,proto3" json:"highlights,omitempty"`
+	// The synonym of the keyword the word matched through, empty when the word matched the keyword itself
+	ExpandedFrom         string   `protobuf:"bytes,5,opt,name=expandedFrom,proto3" json:"expandedFrom,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchMatch) Reset()         { *m = SearchMatch{} }
+func (m *SearchMatch) String() string { return proto.CompactTextString(m) }
+func (*SearchMatch) ProtoMessage()    {}
+func (*SearchMatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{2}
+}
+
+func (m *SearchMatch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchMatch.Unmarshal(m, b)
+}
+func (m *SearchMatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchMatch.Marshal(b, m, deterministic)
+}
+func (m *SearchMatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchMatch.Merge(m, src)
+}
+func (m *SearchMatch) XXX_Size() int {
+	return xxx_messageInfo_SearchMatch.Size(m)
+}
+func (m *SearchMatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchMatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchMatch proto.InternalMessageInfo
+
+func (m *SearchMatch) GetWord() string {
+	if m != nil {
+		return m.Word
+	}
+	return ""
+}
+
+func (m *SearchMatch) GetMetadata() *WordMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *SearchMatch) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *SearchMatch) GetHighlights() []*HighlightSpan {
+	if m != nil {
+		return m.Highlights
+	}
+	return nil
+}
+
+func (m *SearchMatch) GetExpandedFrom() string {
+	if m != nil {
+		return m.ExpandedFrom
+	}
+	return ""
+}
+
+// The rune offsets of a matching part of a word, from start up to but not including end
+type HighlightSpan struct {
+	Start                int32    `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End                  int32    `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HighlightSpan) Reset()         { *m = HighlightSpan{} }
+func (m *HighlightSpan) String() string { return proto.CompactTextString(m) }
+func (*HighlightSpan) ProtoMessage()    {}
+func (*HighlightSpan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{3}
+}
+
+func (m *HighlightSpan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HighlightSpan.Unmarshal(m, b)
+}
+func (m *HighlightSpan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HighlightSpan.Marshal(b, m, deterministic)
+}
+func (m *HighlightSpan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HighlightSpan.Merge(m, src)
+}
+func (m *HighlightSpan) XXX_Size() int {
+	return xxx_messageInfo_HighlightSpan.Size(m)
+}
+func (m *HighlightSpan) XXX_DiscardUnknown() {
+	xxx_messageInfo_HighlightSpan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HighlightSpan proto.InternalMessageInfo
+
+func (m *HighlightSpan) GetStart() int32 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *HighlightSpan) GetEnd() int32 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+type WordMetadata struct {
+	Definition           string            `protobuf:"bytes,1,opt,name=definition,proto3" json:"definition,omitempty"`
+	PartOfSpeech         string            `protobuf:"bytes,2,opt,name=partOfSpeech,proto3" json:"partOfSpeech,omitempty"`
+	Tags                 []string          `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	Attributes           map[string]string `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *WordMetadata) Reset()         { *m = WordMetadata{} }
+func (m *WordMetadata) String() string { return proto.CompactTextString(m) }
+func (*WordMetadata) ProtoMessage()    {}
+func (*WordMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{4}
+}
+
+func (m *WordMetadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WordMetadata.Unmarshal(m, b)
+}
+func (m *WordMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WordMetadata.Marshal(b, m, deterministic)
+}
+func (m *WordMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WordMetadata.Merge(m, src)
+}
+func (m *WordMetadata) XXX_Size() int {
+	return xxx_messageInfo_WordMetadata.Size(m)
+}
+func (m *WordMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_WordMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WordMetadata proto.InternalMessageInfo
+
+func (m *WordMetadata) GetDefinition() string {
+	if m != nil {
+		return m.Definition
+	}
+	return ""
+}
+
+func (m *WordMetadata) GetPartOfSpeech() string {
+	if m != nil {
+		return m.PartOfSpeech
+	}
+	return ""
+}
+
+func (m *WordMetadata) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *WordMetadata) GetAttributes() map[string]string {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+type AddWordsRequest struct {
+	Words      []string `protobuf:"bytes,1,rep,name=words,proto3" json:"words,omitempty"`
+	Dictionary string   `protobuf:"bytes,2,opt,name=dictionary,proto3" json:"dictionary,omitempty"`
+	// When set, the words are removed from the dictionary at this time
+	ExpiresAt *timestamp.Timestamp `protobuf:"bytes,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	// When set, the words are removed from the dictionary this long after being added. Ignored if expiresAt is set
+	Ttl                  *duration.Duration `protobuf:"bytes,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *AddWordsRequest) Reset()         { *m = AddWordsRequest{} }
+func (m *AddWordsRequest) String() string { return proto.CompactTextString(m) }
+func (*AddWordsRequest) ProtoMessage()    {}
+func (*AddWordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{5}
+}
+
+func (m *AddWordsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddWordsRequest.Unmarshal(m, b)
+}
+func (m *AddWordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddWordsRequest.Marshal(b, m, deterministic)
+}
+func (m *AddWordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddWordsRequest.Merge(m, src)
+}
+func (m *AddWordsRequest) XXX_Size() int {
+	return xxx_messageInfo_AddWordsRequest.Size(m)
+}
+func (m *AddWordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddWordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddWordsRequest proto.InternalMessageInfo
+
+func (m *AddWordsRequest) GetWords() []string {
+	if m != nil {
+		return m.Words
+	}
+	return nil
+}
+
+func (m *AddWordsRequest) GetDictionary() string {
+	if m != nil {
+		return m.Dictionary
+	}
+	return ""
+}
+
+func (m *AddWordsRequest) GetExpiresAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *AddWordsRequest) GetTtl() *duration.Duration {
+	if m != nil {
+		return m.Ttl
+	}
+	return nil
+}
+
+type AddWordsReply struct {
+	// The dictionary version the words were added in
+	Version              int64    `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddWordsReply) Reset()         { *m = AddWordsReply{} }
+func (m *AddWordsReply) String() string { return proto.CompactTextString(m) }
+func (*AddWordsReply) ProtoMessage()    {}
+func (*AddWordsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{6}
+}
+
+func (m *AddWordsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddWordsReply.Unmarshal(m, b)
+}
+func (m *AddWordsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddWordsReply.Marshal(b, m, deterministic)
+}
+func (m *AddWordsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddWordsReply.Merge(m, src)
+}
+func (m *AddWordsReply) XXX_Size() int {
+	return xxx_messageInfo_AddWordsReply.Size(m)
+}
+func (m *AddWordsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddWordsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddWordsReply proto.InternalMessageInfo
+
+func (m *AddWordsReply) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type Top5SearchKeyWordsRequest struct {
+	Dictionary           string   `protobuf:"bytes,1,opt,name=dictionary,proto3" json:"dictionary,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Top5SearchKeyWordsRequest) Reset()         { *m = Top5SearchKeyWordsRequest{} }
+func (m *Top5SearchKeyWordsRequest) String() string { return proto.CompactTextString(m) }
+func (*Top5SearchKeyWordsRequest) ProtoMessage()    {}
+func (*Top5SearchKeyWordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{7}
+}
+
+func (m *Top5SearchKeyWordsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Top5SearchKeyWordsRequest.Unmarshal(m, b)
+}
+func (m *Top5SearchKeyWordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Top5SearchKeyWordsRequest.Marshal(b, m, deterministic)
+}
+func (m *Top5SearchKeyWordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Top5SearchKeyWordsRequest.Merge(m, src)
+}
+func (m *Top5SearchKeyWordsRequest) XXX_Size() int {
+	return xxx_messageInfo_Top5SearchKeyWordsRequest.Size(m)
+}
+func (m *Top5SearchKeyWordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_Top5SearchKeyWordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Top5SearchKeyWordsRequest proto.InternalMessageInfo
+
+func (m *Top5SearchKeyWordsRequest) GetDictionary() string {
+	if m != nil {
+		return m.Dictionary
+	}
+	return ""
+}
+
+type Top5SearchKeyWordsReply struct {
+	Keywords             []string `protobuf:"bytes,1,rep,name=keywords,proto3" json:"keywords,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Top5SearchKeyWordsReply) Reset()         { *m = Top5SearchKeyWordsReply{} }
+func (m *Top5SearchKeyWordsReply) String() string { return proto.CompactTextString(m) }
+func (*Top5SearchKeyWordsReply) ProtoMessage()    {}
+func (*Top5SearchKeyWordsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{8}
+}
+
+func (m *Top5SearchKeyWordsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Top5SearchKeyWordsReply.Unmarshal(m, b)
+}
+func (m *Top5SearchKeyWordsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Top5SearchKeyWordsReply.Marshal(b, m, deterministic)
+}
+func (m *Top5SearchKeyWordsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Top5SearchKeyWordsReply.Merge(m, src)
+}
+func (m *Top5SearchKeyWordsReply) XXX_Size() int {
+	return xxx_messageInfo_Top5SearchKeyWordsReply.Size(m)
+}
+func (m *Top5SearchKeyWordsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_Top5SearchKeyWordsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Top5SearchKeyWordsReply proto.InternalMessageInfo
+
+func (m *Top5SearchKeyWordsReply) GetKeywords() []string {
+	if m != nil {
+		return m.Keywords
+	}
+	return nil
+}
+
+type ExportWordsRequest struct {
+	Dictionary string `protobuf:"bytes,1,opt,name=dictionary,proto3" json:"dictionary,omitempty"`
+	// text, jsonl or csv. Defaults to text
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	// The number of words per chunk. Defaults to 1000
+	ChunkSize            int32    `protobuf:"varint,3,opt,name=chunkSize,proto3" json:"chunkSize,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportWordsRequest) Reset()         { *m = ExportWordsRequest{} }
+func (m *ExportWordsRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportWordsRequest) ProtoMessage()    {}
+func (*ExportWordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{9}
+}
+
+func (m *ExportWordsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportWordsRequest.Unmarshal(m, b)
+}
+func (m *ExportWordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportWordsRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportWordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportWordsRequest.Merge(m, src)
+}
+func (m *ExportWordsRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportWordsRequest.Size(m)
+}
+func (m *ExportWordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportWordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportWordsRequest proto.InternalMessageInfo
+
+func (m *ExportWordsRequest) GetDictionary() string {
+	if m != nil {
+		return m.Dictionary
+	}
+	return ""
+}
+
+func (m *ExportWordsRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *ExportWordsRequest) GetChunkSize() int32 {
+	if m != nil {
+		return m.ChunkSize
+	}
+	return 0
+}
+
+type ExportWordsReply struct {
+	// The next words of the export, encoded in the requested format
+	Chunk                []byte   `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportWordsReply) Reset()         { *m = ExportWordsReply{} }
+func (m *ExportWordsReply) String() string { return proto.CompactTextString(m) }
+func (*ExportWordsReply) ProtoMessage()    {}
+func (*ExportWordsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{10}
+}
+
+func (m *ExportWordsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportWordsReply.Unmarshal(m, b)
+}
+func (m *ExportWordsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportWordsReply.Marshal(b, m, deterministic)
+}
+func (m *ExportWordsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportWordsReply.Merge(m, src)
+}
+func (m *ExportWordsReply) XXX_Size() int {
+	return xxx_messageInfo_ExportWordsReply.Size(m)
+}
+func (m *ExportWordsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportWordsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportWordsReply proto.InternalMessageInfo
+
+func (m *ExportWordsReply) GetChunk() []byte {
+	if m != nil {
+		return m.Chunk
+	}
+	return nil
+}
+
+type AddWordsStreamRequest struct {
+	// Read from the first message of the stream
+	Dictionary           string   `protobuf:"bytes,1,opt,name=dictionary,proto3" json:"dictionary,omitempty"`
+	Words                []string `protobuf:"bytes,2,rep,name=words,proto3" json:"words,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddWordsStreamRequest) Reset()         { *m = AddWordsStreamRequest{} }
+func (m *AddWordsStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*AddWordsStreamRequest) ProtoMessage()    {}
+func (*AddWordsStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{11}
+}
+
+func (m *AddWordsStreamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddWordsStreamRequest.Unmarshal(m, b)
+}
+func (m *AddWordsStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddWordsStreamRequest.Marshal(b, m, deterministic)
+}
+func (m *AddWordsStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddWordsStreamRequest.Merge(m, src)
+}
+func (m *AddWordsStreamRequest) XXX_Size() int {
+	return xxx_messageInfo_AddWordsStreamRequest.Size(m)
+}
+func (m *AddWordsStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddWordsStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddWordsStreamRequest proto.InternalMessageInfo
+
+func (m *AddWordsStreamRequest) GetDictionary() string {
+	if m != nil {
+		return m.Dictionary
+	}
+	return ""
+}
+
+func (m *AddWordsStreamRequest) GetWords() []string {
+	if m != nil {
+		return m.Words
+	}
+	return nil
+}
+
+type AddWordsStreamReply struct {
+	Added                int64    `protobuf:"varint,1,opt,name=added,proto3" json:"added,omitempty"`
+	Duplicates           int64    `protobuf:"varint,2,opt,name=duplicates,proto3" json:"duplicates,omitempty"`
+	Rejected             int64    `protobuf:"varint,3,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddWordsStreamReply) Reset()         { *m = AddWordsStreamReply{} }
+func (m *AddWordsStreamReply) String() string { return proto.CompactTextString(m) }
+func (*AddWordsStreamReply) ProtoMessage()    {}
+func (*AddWordsStreamReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{12}
+}
+
+func (m *AddWordsStreamReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddWordsStreamReply.Unmarshal(m, b)
+}
+func (m *AddWordsStreamReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddWordsStreamReply.Marshal(b, m, deterministic)
+}
+func (m *AddWordsStreamReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddWordsStreamReply.Merge(m, src)
+}
+func (m *AddWordsStreamReply) XXX_Size() int {
+	return xxx_messageInfo_AddWordsStreamReply.Size(m)
+}
+func (m *AddWordsStreamReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddWordsStreamReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddWordsStreamReply proto.InternalMessageInfo
+
+func (m *AddWordsStreamReply) GetAdded() int64 {
+	if m != nil {
+		return m.Added
+	}
+	return 0
+}
+
+func (m *AddWordsStreamReply) GetDuplicates() int64 {
+	if m != nil {
+		return m.Duplicates
+	}
+	return 0
+}
+
+func (m *AddWordsStreamReply) GetRejected() int64 {
+	if m != nil {
+		return m.Rejected
+	}
+	return 0
+}
+
+type CreateDictionaryRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateDictionaryRequest) Reset()         { *m = CreateDictionaryRequest{} }
+func (m *CreateDictionaryRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateDictionaryRequest) ProtoMessage()    {}
+func (*CreateDictionaryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{13}
+}
+
+func (m *CreateDictionaryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateDictionaryRequest.Unmarshal(m, b)
+}
+func (m *CreateDictionaryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateDictionaryRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateDictionaryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateDictionaryRequest.Merge(m, src)
+}
+func (m *CreateDictionaryRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateDictionaryRequest.Size(m)
+}
+func (m *CreateDictionaryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateDictionaryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateDictionaryRequest proto.InternalMessageInfo
+
+func (m *CreateDictionaryRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type CreateDictionaryReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateDictionaryReply) Reset()         { *m = CreateDictionaryReply{} }
+func (m *CreateDictionaryReply) String() string { return proto.CompactTextString(m) }
+func (*CreateDictionaryReply) ProtoMessage()    {}
+func (*CreateDictionaryReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{14}
+}
+
+func (m *CreateDictionaryReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateDictionaryReply.Unmarshal(m, b)
+}
+func (m *CreateDictionaryReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateDictionaryReply.Marshal(b, m, deterministic)
+}
+func (m *CreateDictionaryReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateDictionaryReply.Merge(m, src)
+}
+func (m *CreateDictionaryReply) XXX_Size() int {
+	return xxx_messageInfo_CreateDictionaryReply.Size(m)
+}
+func (m *CreateDictionaryReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateDictionaryReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateDictionaryReply proto.InternalMessageInfo
+
+type ListDictionariesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDictionariesRequest) Reset()         { *m = ListDictionariesRequest{} }
+func (m *ListDictionariesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDictionariesRequest) ProtoMessage()    {}
+func (*ListDictionariesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{15}
+}
+
+func (m *ListDictionariesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDictionariesRequest.Unmarshal(m, b)
+}
+func (m *ListDictionariesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDictionariesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListDictionariesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDictionariesRequest.Merge(m, src)
+}
+func (m *ListDictionariesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDictionariesRequest.Size(m)
+}
+func (m *ListDictionariesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDictionariesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDictionariesRequest proto.InternalMessageInfo
+
+type ListDictionariesReply struct {
+	Names                []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDictionariesReply) Reset()         { *m = ListDictionariesReply{} }
+func (m *ListDictionariesReply) String() string { return proto.CompactTextString(m) }
+func (*ListDictionariesReply) ProtoMessage()    {}
+func (*ListDictionariesReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{16}
+}
+
+func (m *ListDictionariesReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDictionariesReply.Unmarshal(m, b)
+}
+func (m *ListDictionariesReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDictionariesReply.Marshal(b, m, deterministic)
+}
+func (m *ListDictionariesReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDictionariesReply.Merge(m, src)
+}
+func (m *ListDictionariesReply) XXX_Size() int {
+	return xxx_messageInfo_ListDictionariesReply.Size(m)
+}
+func (m *ListDictionariesReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDictionariesReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDictionariesReply proto.InternalMessageInfo
+
+func (m *ListDictionariesReply) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+type DeleteDictionaryRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteDictionaryRequest) Reset()         { *m = DeleteDictionaryRequest{} }
+func (m *DeleteDictionaryRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteDictionaryRequest) ProtoMessage()    {}
+func (*DeleteDictionaryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{17}
+}
+
+func (m *DeleteDictionaryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteDictionaryRequest.Unmarshal(m, b)
+}
+func (m *DeleteDictionaryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteDictionaryRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteDictionaryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteDictionaryRequest.Merge(m, src)
+}
+func (m *DeleteDictionaryRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteDictionaryRequest.Size(m)
+}
+func (m *DeleteDictionaryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteDictionaryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteDictionaryRequest proto.InternalMessageInfo
+
+func (m *DeleteDictionaryRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type DeleteDictionaryReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteDictionaryReply) Reset()         { *m = DeleteDictionaryReply{} }
+func (m *DeleteDictionaryReply) String() string { return proto.CompactTextString(m) }
+func (*DeleteDictionaryReply) ProtoMessage()    {}
+func (*DeleteDictionaryReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{18}
+}
+
+func (m *DeleteDictionaryReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteDictionaryReply.Unmarshal(m, b)
+}
+func (m *DeleteDictionaryReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteDictionaryReply.Marshal(b, m, deterministic)
+}
+func (m *DeleteDictionaryReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteDictionaryReply.Merge(m, src)
+}
+func (m *DeleteDictionaryReply) XXX_Size() int {
+	return xxx_messageInfo_DeleteDictionaryReply.Size(m)
+}
+func (m *DeleteDictionaryReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteDictionaryReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteDictionaryReply proto.InternalMessageInfo
+
+type ListVersionsRequest struct {
+	Dictionary           string   `protobuf:"bytes,1,opt,name=dictionary,proto3" json:"dictionary,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListVersionsRequest) Reset()         { *m = ListVersionsRequest{} }
+func (m *ListVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListVersionsRequest) ProtoMessage()    {}
+func (*ListVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{19}
+}
+
+func (m *ListVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListVersionsRequest.Unmarshal(m, b)
+}
+func (m *ListVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListVersionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListVersionsRequest.Merge(m, src)
+}
+func (m *ListVersionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListVersionsRequest.Size(m)
+}
+func (m *ListVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListVersionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListVersionsRequest proto.InternalMessageInfo
+
+func (m *ListVersionsRequest) GetDictionary() string {
+	if m != nil {
+		return m.Dictionary
+	}
+	return ""
+}
+
+type ListVersionsReply struct {
+	Versions             []*DictionaryVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ListVersionsReply) Reset()         { *m = ListVersionsReply{} }
+func (m *ListVersionsReply) String() string { return proto.CompactTextString(m) }
+func (*ListVersionsReply) ProtoMessage()    {}
+func (*ListVersionsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{20}
+}
+
+func (m *ListVersionsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListVersionsReply.Unmarshal(m, b)
+}
+func (m *ListVersionsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListVersionsReply.Marshal(b, m, deterministic)
+}
+func (m *ListVersionsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListVersionsReply.Merge(m, src)
+}
+func (m *ListVersionsReply) XXX_Size() int {
+	return xxx_messageInfo_ListVersionsReply.Size(m)
+}
+func (m *ListVersionsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListVersionsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListVersionsReply proto.InternalMessageInfo
+
+func (m *ListVersionsReply) GetVersions() []*DictionaryVersion {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+type DictionaryVersion struct {
+	Version              int64                `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,2,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Description          string               `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	WordsAdded           int32                `protobuf:"varint,4,opt,name=wordsAdded,proto3" json:"wordsAdded,omitempty"`
+	WordsUpdated         int32                `protobuf:"varint,5,opt,name=wordsUpdated,proto3" json:"wordsUpdated,omitempty"`
+	WordsRemoved         int32                `protobuf:"varint,6,opt,name=wordsRemoved,proto3" json:"wordsRemoved,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *DictionaryVersion) Reset()         { *m = DictionaryVersion{} }
+func (m *DictionaryVersion) String() string { return proto.CompactTextString(m) }
+func (*DictionaryVersion) ProtoMessage()    {}
+func (*DictionaryVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{21}
+}
+
+func (m *DictionaryVersion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DictionaryVersion.Unmarshal(m, b)
+}
+func (m *DictionaryVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DictionaryVersion.Marshal(b, m, deterministic)
+}
+func (m *DictionaryVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DictionaryVersion.Merge(m, src)
+}
+func (m *DictionaryVersion) XXX_Size() int {
+	return xxx_messageInfo_DictionaryVersion.Size(m)
+}
+func (m *DictionaryVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_DictionaryVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DictionaryVersion proto.InternalMessageInfo
+
+func (m *DictionaryVersion) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *DictionaryVersion) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *DictionaryVersion) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *DictionaryVersion) GetWordsAdded() int32 {
+	if m != nil {
+		return m.WordsAdded
+	}
+	return 0
+}
+
+func (m *DictionaryVersion) GetWordsUpdated() int32 {
+	if m != nil {
+		return m.WordsUpdated
+	}
+	return 0
+}
+
+func (m *DictionaryVersion) GetWordsRemoved() int32 {
+	if m != nil {
+		return m.WordsRemoved
+	}
+	return 0
+}
+
+type RollbackDictionaryRequest struct {
+	Dictionary string `protobuf:"bytes,1,opt,name=dictionary,proto3" json:"dictionary,omitempty"`
+	// The version to restore
+	Version              int64    `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RollbackDictionaryRequest) Reset()         { *m = RollbackDictionaryRequest{} }
+func (m *RollbackDictionaryRequest) String() string { return proto.CompactTextString(m) }
+func (*RollbackDictionaryRequest) ProtoMessage()    {}
+func (*RollbackDictionaryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{22}
+}
+
+func (m *RollbackDictionaryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RollbackDictionaryRequest.Unmarshal(m, b)
+}
+func (m *RollbackDictionaryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RollbackDictionaryRequest.Marshal(b, m, deterministic)
+}
+func (m *RollbackDictionaryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackDictionaryRequest.Merge(m, src)
+}
+func (m *RollbackDictionaryRequest) XXX_Size() int {
+	return xxx_messageInfo_RollbackDictionaryRequest.Size(m)
+}
+func (m *RollbackDictionaryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackDictionaryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackDictionaryRequest proto.InternalMessageInfo
+
+func (m *RollbackDictionaryRequest) GetDictionary() string {
+	if m != nil {
+		return m.Dictionary
+	}
+	return ""
+}
+
+func (m *RollbackDictionaryRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type RollbackDictionaryReply struct {
+	// The version the rollback produced
+	Version              int64    `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RollbackDictionaryReply) Reset()         { *m = RollbackDictionaryReply{} }
+func (m *RollbackDictionaryReply) String() string { return proto.CompactTextString(m) }
+func (*RollbackDictionaryReply) ProtoMessage()    {}
+func (*RollbackDictionaryReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{23}
+}
+
+func (m *RollbackDictionaryReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RollbackDictionaryReply.Unmarshal(m, b)
+}
+func (m *RollbackDictionaryReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RollbackDictionaryReply.Marshal(b, m, deterministic)
+}
+func (m *RollbackDictionaryReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackDictionaryReply.Merge(m, src)
+}
+func (m *RollbackDictionaryReply) XXX_Size() int {
+	return xxx_messageInfo_RollbackDictionaryReply.Size(m)
+}
+func (m *RollbackDictionaryReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackDictionaryReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackDictionaryReply proto.InternalMessageInfo
+
+func (m *RollbackDictionaryReply) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type WordEntry struct {
+	Word     string        `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	Metadata *WordMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// When the word is removed from the dictionary, as described by AddWordsRequest
+	ExpiresAt            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	Ttl                  *duration.Duration   `protobuf:"bytes,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *WordEntry) Reset()         { *m = WordEntry{} }
+func (m *WordEntry) String() string { return proto.CompactTextString(m) }
+func (*WordEntry) ProtoMessage()    {}
+func (*WordEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{24}
+}
+
+func (m *WordEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WordEntry.Unmarshal(m, b)
+}
+func (m *WordEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WordEntry.Marshal(b, m, deterministic)
+}
+func (m *WordEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WordEntry.Merge(m, src)
+}
+func (m *WordEntry) XXX_Size() int {
+	return xxx_messageInfo_WordEntry.Size(m)
+}
+func (m *WordEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_WordEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WordEntry proto.InternalMessageInfo
+
+func (m *WordEntry) GetWord() string {
+	if m != nil {
+		return m.Word
+	}
+	return ""
+}
+
+func (m *WordEntry) GetMetadata() *WordMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *WordEntry) GetExpiresAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *WordEntry) GetTtl() *duration.Duration {
+	if m != nil {
+		return m.Ttl
+	}
+	return nil
+}
+
+type UpsertWordsRequest struct {
+	Dictionary           string       `protobuf:"bytes,1,opt,name=dictionary,proto3" json:"dictionary,omitempty"`
+	Words                []*WordEntry `protobuf:"bytes,2,rep,name=words,proto3" json:"words,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *UpsertWordsRequest) Reset()         { *m = UpsertWordsRequest{} }
+func (m *UpsertWordsRequest) String() string { return proto.CompactTextString(m) }
+func (*UpsertWordsRequest) ProtoMessage()    {}
+func (*UpsertWordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{25}
+}
+
+func (m *UpsertWordsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpsertWordsRequest.Unmarshal(m, b)
+}
+func (m *UpsertWordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpsertWordsRequest.Marshal(b, m, deterministic)
+}
+func (m *UpsertWordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertWordsRequest.Merge(m, src)
+}
+func (m *UpsertWordsRequest) XXX_Size() int {
+	return xxx_messageInfo_UpsertWordsRequest.Size(m)
+}
+func (m *UpsertWordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertWordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertWordsRequest proto.InternalMessageInfo
+
+func (m *UpsertWordsRequest) GetDictionary() string {
+	if m != nil {
+		return m.Dictionary
+	}
+	return ""
+}
+
+func (m *UpsertWordsRequest) GetWords() []*WordEntry {
+	if m != nil {
+		return m.Words
+	}
+	return nil
+}
+
+type UpsertWordsReply struct {
+	// The dictionary version the words were upserted in
+	Version              int64    `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpsertWordsReply) Reset()         { *m = UpsertWordsReply{} }
+func (m *UpsertWordsReply) String() string { return proto.CompactTextString(m) }
+func (*UpsertWordsReply) ProtoMessage()    {}
+func (*UpsertWordsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{26}
+}
+
+func (m *UpsertWordsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpsertWordsReply.Unmarshal(m, b)
+}
+func (m *UpsertWordsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpsertWordsReply.Marshal(b, m, deterministic)
+}
+func (m *UpsertWordsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertWordsReply.Merge(m, src)
+}
+func (m *UpsertWordsReply) XXX_Size() int {
+	return xxx_messageInfo_UpsertWordsReply.Size(m)
+}
+func (m *UpsertWordsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertWordsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertWordsReply proto.InternalMessageInfo
+
+func (m *UpsertWordsReply) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type ValidateWordsRequest struct {
+	Dictionary           string   `protobuf:"bytes,1,opt,name=dictionary,proto3" json:"dictionary,omitempty"`
+	Words                []string `protobuf:"bytes,2,rep,name=words,proto3" json:"words,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateWordsRequest) Reset()         { *m = ValidateWordsRequest{} }
+func (m *ValidateWordsRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidateWordsRequest) ProtoMessage()    {}
+func (*ValidateWordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{27}
+}
+
+func (m *ValidateWordsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateWordsRequest.Unmarshal(m, b)
+}
+func (m *ValidateWordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateWordsRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidateWordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateWordsRequest.Merge(m, src)
+}
+func (m *ValidateWordsRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidateWordsRequest.Size(m)
+}
+func (m *ValidateWordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateWordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateWordsRequest proto.InternalMessageInfo
+
+func (m *ValidateWordsRequest) GetDictionary() string {
+	if m != nil {
+		return m.Dictionary
+	}
+	return ""
+}
+
+func (m *ValidateWordsRequest) GetWords() []string {
+	if m != nil {
+		return m.Words
+	}
+	return nil
+}
+
+type ValidateWordsReply struct {
+	Violations []*WordViolation `protobuf:"bytes,1,rep,name=violations,proto3" json:"violations,omitempty"`
+	// True when the words which would be accepted do not fit within the dictionary's word limit
+	ExceedsWordLimit     bool     `protobuf:"varint,2,opt,name=exceedsWordLimit,proto3" json:"exceedsWordLimit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateWordsReply) Reset()         { *m = ValidateWordsReply{} }
+func (m *ValidateWordsReply) String() string { return proto.CompactTextString(m) }
+func (*ValidateWordsReply) ProtoMessage()    {}
+func (*ValidateWordsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{28}
+}
+
+func (m *ValidateWordsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateWordsReply.Unmarshal(m, b)
+}
+func (m *ValidateWordsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateWordsReply.Marshal(b, m, deterministic)
+}
+func (m *ValidateWordsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateWordsReply.Merge(m, src)
+}
+func (m *ValidateWordsReply) XXX_Size() int {
+	return xxx_messageInfo_ValidateWordsReply.Size(m)
+}
+func (m *ValidateWordsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateWordsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateWordsReply proto.InternalMessageInfo
+
+func (m *ValidateWordsReply) GetViolations() []*WordViolation {
+	if m != nil {
+		return m.Violations
+	}
+	return nil
+}
+
+func (m *ValidateWordsReply) GetExceedsWordLimit() bool {
+	if m != nil {
+		return m.ExceedsWordLimit
+	}
+	return false
+}
+
+type WordViolation struct {
+	// The position of the word in the request
+	Index                int32    `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Word                 string   `protobuf:"bytes,2,opt,name=word,proto3" json:"word,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WordViolation) Reset()         { *m = WordViolation{} }
+func (m *WordViolation) String() string { return proto.CompactTextString(m) }
+func (*WordViolation) ProtoMessage()    {}
+func (*WordViolation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{29}
+}
+
+func (m *WordViolation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WordViolation.Unmarshal(m, b)
+}
+func (m *WordViolation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WordViolation.Marshal(b, m, deterministic)
+}
+func (m *WordViolation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WordViolation.Merge(m, src)
+}
+func (m *WordViolation) XXX_Size() int {
+	return xxx_messageInfo_WordViolation.Size(m)
+}
+func (m *WordViolation) XXX_DiscardUnknown() {
+	xxx_messageInfo_WordViolation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WordViolation proto.InternalMessageInfo
+
+func (m *WordViolation) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *WordViolation) GetWord() string {
+	if m != nil {
+		return m.Word
+	}
+	return ""
+}
+
+func (m *WordViolation) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type AddBlockedTermsRequest struct {
+	Terms                []string `protobuf:"bytes,1,rep,name=terms,proto3" json:"terms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddBlockedTermsRequest) Reset()         { *m = AddBlockedTermsRequest{} }
+func (m *AddBlockedTermsRequest) String() string { return proto.CompactTextString(m) }
+func (*AddBlockedTermsRequest) ProtoMessage()    {}
+func (*AddBlockedTermsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{30}
+}
+
+func (m *AddBlockedTermsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddBlockedTermsRequest.Unmarshal(m, b)
+}
+func (m *AddBlockedTermsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddBlockedTermsRequest.Marshal(b, m, deterministic)
+}
+func (m *AddBlockedTermsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddBlockedTermsRequest.Merge(m, src)
+}
+func (m *AddBlockedTermsRequest) XXX_Size() int {
+	return xxx_messageInfo_AddBlockedTermsRequest.Size(m)
+}
+func (m *AddBlockedTermsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddBlockedTermsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddBlockedTermsRequest proto.InternalMessageInfo
+
+func (m *AddBlockedTermsRequest) GetTerms() []string {
+	if m != nil {
+		return m.Terms
+	}
+	return nil
+}
+
+type AddBlockedTermsReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddBlockedTermsReply) Reset()         { *m = AddBlockedTermsReply{} }
+func (m *AddBlockedTermsReply) String() string { return proto.CompactTextString(m) }
+func (*AddBlockedTermsReply) ProtoMessage()    {}
+func (*AddBlockedTermsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{31}
+}
+
+func (m *AddBlockedTermsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddBlockedTermsReply.Unmarshal(m, b)
+}
+func (m *AddBlockedTermsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddBlockedTermsReply.Marshal(b, m, deterministic)
+}
+func (m *AddBlockedTermsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddBlockedTermsReply.Merge(m, src)
+}
+func (m *AddBlockedTermsReply) XXX_Size() int {
+	return xxx_messageInfo_AddBlockedTermsReply.Size(m)
+}
+func (m *AddBlockedTermsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddBlockedTermsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddBlockedTermsReply proto.InternalMessageInfo
+
+type RemoveBlockedTermsRequest struct {
+	Terms                []string `protobuf:"bytes,1,rep,name=terms,proto3" json:"terms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveBlockedTermsRequest) Reset()         { *m = RemoveBlockedTermsRequest{} }
+func (m *RemoveBlockedTermsRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveBlockedTermsRequest) ProtoMessage()    {}
+func (*RemoveBlockedTermsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{32}
+}
+
+func (m *RemoveBlockedTermsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveBlockedTermsRequest.Unmarshal(m, b)
+}
+func (m *RemoveBlockedTermsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveBlockedTermsRequest.Marshal(b, m, deterministic)
+}
+func (m *RemoveBlockedTermsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveBlockedTermsRequest.Merge(m, src)
+}
+func (m *RemoveBlockedTermsRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveBlockedTermsRequest.Size(m)
+}
+func (m *RemoveBlockedTermsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveBlockedTermsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveBlockedTermsRequest proto.InternalMessageInfo
+
+func (m *RemoveBlockedTermsRequest) GetTerms() []string {
+	if m != nil {
+		return m.Terms
+	}
+	return nil
+}
+
+type RemoveBlockedTermsReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveBlockedTermsReply) Reset()         { *m = RemoveBlockedTermsReply{} }
+func (m *RemoveBlockedTermsReply) String() string { return proto.CompactTextString(m) }
+func (*RemoveBlockedTermsReply) ProtoMessage()    {}
+func (*RemoveBlockedTermsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{33}
+}
+
+func (m *RemoveBlockedTermsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveBlockedTermsReply.Unmarshal(m, b)
+}
+func (m *RemoveBlockedTermsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveBlockedTermsReply.Marshal(b, m, deterministic)
+}
+func (m *RemoveBlockedTermsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveBlockedTermsReply.Merge(m, src)
+}
+func (m *RemoveBlockedTermsReply) XXX_Size() int {
+	return xxx_messageInfo_RemoveBlockedTermsReply.Size(m)
+}
+func (m *RemoveBlockedTermsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveBlockedTermsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveBlockedTermsReply proto.InternalMessageInfo
+
+type ListBlockedTermsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListBlockedTermsRequest) Reset()         { *m = ListBlockedTermsRequest{} }
+func (m *ListBlockedTermsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListBlockedTermsRequest) ProtoMessage()    {}
+func (*ListBlockedTermsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{34}
+}
+
+func (m *ListBlockedTermsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListBlockedTermsRequest.Unmarshal(m, b)
+}
+func (m *ListBlockedTermsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListBlockedTermsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListBlockedTermsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBlockedTermsRequest.Merge(m, src)
+}
+func (m *ListBlockedTermsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListBlockedTermsRequest.Size(m)
+}
+func (m *ListBlockedTermsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBlockedTermsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListBlockedTermsRequest proto.InternalMessageInfo
+
+type ListBlockedTermsReply struct {
+	Terms                []string `protobuf:"bytes,1,rep,name=terms,proto3" json:"terms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListBlockedTermsReply) Reset()         { *m = ListBlockedTermsReply{} }
+func (m *ListBlockedTermsReply) String() string { return proto.CompactTextString(m) }
+func (*ListBlockedTermsReply) ProtoMessage()    {}
+func (*ListBlockedTermsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{35}
+}
+
+func (m *ListBlockedTermsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListBlockedTermsReply.Unmarshal(m, b)
+}
+func (m *ListBlockedTermsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListBlockedTermsReply.Marshal(b, m, deterministic)
+}
+func (m *ListBlockedTermsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBlockedTermsReply.Merge(m, src)
+}
+func (m *ListBlockedTermsReply) XXX_Size() int {
+	return xxx_messageInfo_ListBlockedTermsReply.Size(m)
+}
+func (m *ListBlockedTermsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBlockedTermsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListBlockedTermsReply proto.InternalMessageInfo
+
+func (m *ListBlockedTermsReply) GetTerms() []string {
+	if m != nil {
+		return m.Terms
+	}
+	return nil
+}
+
+// Empty fields do not restrict the events listed
+type ListAuditEventsRequest struct {
+	Dictionary string `protobuf:"bytes,1,opt,name=dictionary,proto3" json:"dictionary,omitempty"`
+	Caller     string `protobuf:"bytes,2,opt,name=caller,proto3" json:"caller,omitempty"`
+	Action     string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	// Lists the events which changed this word
+	Word     string               `protobuf:"bytes,4,opt,name=word,proto3" json:"word,omitempty"`
+	Since    *timestamp.Timestamp `protobuf:"bytes,5,opt,name=since,proto3" json:"since,omitempty"`
+	Until    *timestamp.Timestamp `protobuf:"bytes,6,opt,name=until,proto3" json:"until,omitempty"`
+	PageSize int32                `protobuf:"varint,7,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	// Empty for the first page, and the nextPageToken of the previous reply for the following pages
+	PageToken            string   `protobuf:"bytes,8,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAuditEventsRequest) Reset()         { *m = ListAuditEventsRequest{} }
+func (m *ListAuditEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAuditEventsRequest) ProtoMessage()    {}
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{36}
+}
+
+func (m *ListAuditEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAuditEventsRequest.Unmarshal(m, b)
+}
+func (m *ListAuditEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAuditEventsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListAuditEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuditEventsRequest.Merge(m, src)
+}
+func (m *ListAuditEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAuditEventsRequest.Size(m)
+}
+func (m *ListAuditEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuditEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAuditEventsRequest proto.InternalMessageInfo
+
+func (m *ListAuditEventsRequest) GetDictionary() string {
+	if m != nil {
+		return m.Dictionary
+	}
+	return ""
+}
+
+func (m *ListAuditEventsRequest) GetCaller() string {
+	if m != nil {
+		return m.Caller
+	}
+	return ""
+}
+
+func (m *ListAuditEventsRequest) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *ListAuditEventsRequest) GetWord() string {
+	if m != nil {
+		return m.Word
+	}
+	return ""
+}
+
+func (m *ListAuditEventsRequest) GetSince() *timestamp.Timestamp {
+	if m != nil {
+		return m.Since
+	}
+	return nil
+}
+
+func (m *ListAuditEventsRequest) GetUntil() *timestamp.Timestamp {
+	if m != nil {
+		return m.Until
+	}
+	return nil
+}
+
+func (m *ListAuditEventsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListAuditEventsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type AuditWordChange struct {
+	Word                 string   `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	ExistedBefore        bool     `protobuf:"varint,2,opt,name=existedBefore,proto3" json:"existedBefore,omitempty"`
+	ExistsAfter          bool     `protobuf:"varint,3,opt,name=existsAfter,proto3" json:"existsAfter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditWordChange) Reset()         { *m = AuditWordChange{} }
+func (m *AuditWordChange) String() string { return proto.CompactTextString(m) }
+func (*AuditWordChange) ProtoMessage()    {}
+func (*AuditWordChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{37}
+}
+
+func (m *AuditWordChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditWordChange.Unmarshal(m, b)
+}
+func (m *AuditWordChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditWordChange.Marshal(b, m, deterministic)
+}
+func (m *AuditWordChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditWordChange.Merge(m, src)
+}
+func (m *AuditWordChange) XXX_Size() int {
+	return xxx_messageInfo_AuditWordChange.Size(m)
+}
+func (m *AuditWordChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditWordChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditWordChange proto.InternalMessageInfo
+
+func (m *AuditWordChange) GetWord() string {
+	if m != nil {
+		return m.Word
+	}
+	return ""
+}
+
+func (m *AuditWordChange) GetExistedBefore() bool {
+	if m != nil {
+		return m.ExistedBefore
+	}
+	return false
+}
+
+func (m *AuditWordChange) GetExistsAfter() bool {
+	if m != nil {
+		return m.ExistsAfter
+	}
+	return false
+}
+
+type AuditEvent struct {
+	Id          int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Time        *timestamp.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Caller      string               `protobuf:"bytes,3,opt,name=caller,proto3" json:"caller,omitempty"`
+	PeerAddress string               `protobuf:"bytes,4,opt,name=peerAddress,proto3" json:"peerAddress,omitempty"`
+	RequestId   string               `protobuf:"bytes,5,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	Dictionary  string               `protobuf:"bytes,6,opt,name=dictionary,proto3" json:"dictionary,omitempty"`
+	Action      string               `protobuf:"bytes,7,opt,name=action,proto3" json:"action,omitempty"`
+	// The dictionary version the mutation produced, or 0 if the mutation failed
+	Version              int64              `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	Changes              []*AuditWordChange `protobuf:"bytes,9,rep,name=changes,proto3" json:"changes,omitempty"`
+	Error                string             `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *AuditEvent) Reset()         { *m = AuditEvent{} }
+func (m *AuditEvent) String() string { return proto.CompactTextString(m) }
+func (*AuditEvent) ProtoMessage()    {}
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{38}
+}
+
+func (m *AuditEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditEvent.Unmarshal(m, b)
+}
+func (m *AuditEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditEvent.Marshal(b, m, deterministic)
+}
+func (m *AuditEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEvent.Merge(m, src)
+}
+func (m *AuditEvent) XXX_Size() int {
+	return xxx_messageInfo_AuditEvent.Size(m)
+}
+func (m *AuditEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditEvent proto.InternalMessageInfo
+
+func (m *AuditEvent) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *AuditEvent) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *AuditEvent) GetCaller() string {
+	if m != nil {
+		return m.Caller
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetPeerAddress() string {
+	if m != nil {
+		return m.PeerAddress
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetDictionary() string {
+	if m != nil {
+		return m.Dictionary
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *AuditEvent) GetChanges() []*AuditWordChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+func (m *AuditEvent) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ListAuditEventsReply struct {
+	Events []*AuditEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// Empty on the last page
+	NextPageToken        string   `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAuditEventsReply) Reset()         { *m = ListAuditEventsReply{} }
+func (m *ListAuditEventsReply) String() string { return proto.CompactTextString(m) }
+func (*ListAuditEventsReply) ProtoMessage()    {}
+func (*ListAuditEventsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{39}
+}
+
+func (m *ListAuditEventsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAuditEventsReply.Unmarshal(m, b)
+}
+func (m *ListAuditEventsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAuditEventsReply.Marshal(b, m, deterministic)
+}
+func (m *ListAuditEventsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuditEventsReply.Merge(m, src)
+}
+func (m *ListAuditEventsReply) XXX_Size() int {
+	return xxx_messageInfo_ListAuditEventsReply.Size(m)
+}
+func (m *ListAuditEventsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuditEventsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAuditEventsReply proto.InternalMessageInfo
+
+func (m *ListAuditEventsReply) GetEvents() []*AuditEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *ListAuditEventsReply) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type SearchWordStreamRequest struct {
+	KeyWord    string `protobuf:"bytes,1,opt,name=keyWord,proto3" json:"keyWord,omitempty"`
+	Dictionary string `protobuf:"bytes,2,opt,name=dictionary,proto3" json:"dictionary,omitempty"`
+	// The number of matches in each reply. Defaults to 100
+	BatchSize int32 `protobuf:"varint,3,opt,name=batchSize,proto3" json:"batchSize,omitempty"`
+	// As for SearchWordRequest
+	IncludeMetadata      bool      `protobuf:"varint,4,opt,name=includeMetadata,proto3" json:"includeMetadata,omitempty"`
+	PartOfSpeech         string    `protobuf:"bytes,5,opt,name=partOfSpeech,proto3" json:"partOfSpeech,omitempty"`
+	Tags                 []string  `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	IncludeHighlights    bool      `protobuf:"varint,7,opt,name=includeHighlights,proto3" json:"includeHighlights,omitempty"`
+	Stemming             bool      `protobuf:"varint,8,opt,name=stemming,proto3" json:"stemming,omitempty"`
+	ExpandSynonyms       bool      `protobuf:"varint,9,opt,name=expandSynonyms,proto3" json:"expandSynonyms,omitempty"`
+	MatchMode            MatchMode `protobuf:"varint,10,opt,name=matchMode,proto3,enum=wordsearchsystemgrpc.MatchMode" json:"matchMode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *SearchWordStreamRequest) Reset()         { *m = SearchWordStreamRequest{} }
+func (m *SearchWordStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchWordStreamRequest) ProtoMessage()    {}
+func (*SearchWordStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{40}
+}
+
+func (m *SearchWordStreamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchWordStreamRequest.Unmarshal(m, b)
+}
+func (m *SearchWordStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchWordStreamRequest.Marshal(b, m, deterministic)
+}
+func (m *SearchWordStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchWordStreamRequest.Merge(m, src)
+}
+func (m *SearchWordStreamRequest) XXX_Size() int {
+	return xxx_messageInfo_SearchWordStreamRequest.Size(m)
+}
+func (m *SearchWordStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchWordStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchWordStreamRequest proto.InternalMessageInfo
+
+func (m *SearchWordStreamRequest) GetKeyWord() string {
+	if m != nil {
+		return m.KeyWord
+	}
+	return ""
+}
+
+func (m *SearchWordStreamRequest) GetDictionary() string {
+	if m != nil {
+		return m.Dictionary
+	}
+	return ""
+}
+
+func (m *SearchWordStreamRequest) GetBatchSize() int32 {
+	if m != nil {
+		return m.BatchSize
+	}
+	return 0
+}
+
+func (m *SearchWordStreamRequest) GetIncludeMetadata() bool {
+	if m != nil {
+		return m.IncludeMetadata
+	}
+	return false
+}
+
+func (m *SearchWordStreamRequest) GetPartOfSpeech() string {
+	if m != nil {
+		return m.PartOfSpeech
+	}
+	return ""
+}
+
+func (m *SearchWordStreamRequest) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *SearchWordStreamRequest) GetIncludeHighlights() bool {
+	if m != nil {
+		return m.IncludeHighlights
+	}
+	return false
+}
+
+func (m *SearchWordStreamRequest) GetStemming() bool {
+	if m != nil {
+		return m.Stemming
+	}
+	return false
+}
+
+func (m *SearchWordStreamRequest) GetExpandSynonyms() bool {
+	if m != nil {
+		return m.ExpandSynonyms
+	}
+	return false
+}
+
+func (m *SearchWordStreamRequest) GetMatchMode() MatchMode {
+	if m != nil {
+		return m.MatchMode
+	}
+	return MatchMode_MATCH_SUBSTRING
+}
+
+type SearchWordStreamReply struct {
+	Matches              []string       `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	Results              []*SearchMatch `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SearchWordStreamReply) Reset()         { *m = SearchWordStreamReply{} }
+func (m *SearchWordStreamReply) String() string { return proto.CompactTextString(m) }
+func (*SearchWordStreamReply) ProtoMessage()    {}
+func (*SearchWordStreamReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{41}
+}
+
+func (m *SearchWordStreamReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchWordStreamReply.Unmarshal(m, b)
+}
+func (m *SearchWordStreamReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchWordStreamReply.Marshal(b, m, deterministic)
+}
+func (m *SearchWordStreamReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchWordStreamReply.Merge(m, src)
+}
+func (m *SearchWordStreamReply) XXX_Size() int {
+	return xxx_messageInfo_SearchWordStreamReply.Size(m)
+}
+func (m *SearchWordStreamReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchWordStreamReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchWordStreamReply proto.InternalMessageInfo
+
+func (m *SearchWordStreamReply) GetMatches() []string {
+	if m != nil {
+		return m.Matches
+	}
+	return nil
+}
+
+func (m *SearchWordStreamReply) GetResults() []*SearchMatch {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type QueryWordsRequest struct {
+	Query                string   `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Dictionary           string   `protobuf:"bytes,2,opt,name=dictionary,proto3" json:"dictionary,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryWordsRequest) Reset()         { *m = QueryWordsRequest{} }
+func (m *QueryWordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWordsRequest) ProtoMessage()    {}
+func (*QueryWordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{42}
+}
+
+func (m *QueryWordsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryWordsRequest.Unmarshal(m, b)
+}
+func (m *QueryWordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryWordsRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryWordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWordsRequest.Merge(m, src)
+}
+func (m *QueryWordsRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryWordsRequest.Size(m)
+}
+func (m *QueryWordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWordsRequest proto.InternalMessageInfo
+
+func (m *QueryWordsRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *QueryWordsRequest) GetDictionary() string {
+	if m != nil {
+		return m.Dictionary
+	}
+	return ""
+}
+
+type QueryWordsReply struct {
+	Matches              []string `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryWordsReply) Reset()         { *m = QueryWordsReply{} }
+func (m *QueryWordsReply) String() string { return proto.CompactTextString(m) }
+func (*QueryWordsReply) ProtoMessage()    {}
+func (*QueryWordsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{43}
+}
+
+func (m *QueryWordsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryWordsReply.Unmarshal(m, b)
+}
+func (m *QueryWordsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryWordsReply.Marshal(b, m, deterministic)
+}
+func (m *QueryWordsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWordsReply.Merge(m, src)
+}
+func (m *QueryWordsReply) XXX_Size() int {
+	return xxx_messageInfo_QueryWordsReply.Size(m)
+}
+func (m *QueryWordsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWordsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWordsReply proto.InternalMessageInfo
+
+func (m *QueryWordsReply) GetMatches() []string {
+	if m != nil {
+		return m.Matches
+	}
+	return nil
+}
+
+type SynonymGroup struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Words                []string `protobuf:"bytes,2,rep,name=words,proto3" json:"words,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SynonymGroup) Reset()         { *m = SynonymGroup{} }
+func (m *SynonymGroup) String() string { return proto.CompactTextString(m) }
+func (*SynonymGroup) ProtoMessage()    {}
+func (*SynonymGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{44}
+}
+
+func (m *SynonymGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SynonymGroup.Unmarshal(m, b)
+}
+func (m *SynonymGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SynonymGroup.Marshal(b, m, deterministic)
+}
+func (m *SynonymGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SynonymGroup.Merge(m, src)
+}
+func (m *SynonymGroup) XXX_Size() int {
+	return xxx_messageInfo_SynonymGroup.Size(m)
+}
+func (m *SynonymGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_SynonymGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SynonymGroup proto.InternalMessageInfo
+
+func (m *SynonymGroup) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *SynonymGroup) GetWords() []string {
+	if m != nil {
+		return m.Words
+	}
+	return nil
+}
+
+type AddSynonymGroupRequest struct {
+	// At least two different words
+	Words                []string `protobuf:"bytes,1,rep,name=words,proto3" json:"words,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddSynonymGroupRequest) Reset()         { *m = AddSynonymGroupRequest{} }
+func (m *AddSynonymGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*AddSynonymGroupRequest) ProtoMessage()    {}
+func (*AddSynonymGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{45}
+}
+
+func (m *AddSynonymGroupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddSynonymGroupRequest.Unmarshal(m, b)
+}
+func (m *AddSynonymGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddSynonymGroupRequest.Marshal(b, m, deterministic)
+}
+func (m *AddSynonymGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddSynonymGroupRequest.Merge(m, src)
+}
+func (m *AddSynonymGroupRequest) XXX_Size() int {
+	return xxx_messageInfo_AddSynonymGroupRequest.Size(m)
+}
+func (m *AddSynonymGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddSynonymGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddSynonymGroupRequest proto.InternalMessageInfo
+
+func (m *AddSynonymGroupRequest) GetWords() []string {
+	if m != nil {
+		return m.Words
+	}
+	return nil
+}
+
+type AddSynonymGroupReply struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddSynonymGroupReply) Reset()         { *m = AddSynonymGroupReply{} }
+func (m *AddSynonymGroupReply) String() string { return proto.CompactTextString(m) }
+func (*AddSynonymGroupReply) ProtoMessage()    {}
+func (*AddSynonymGroupReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{46}
+}
+
+func (m *AddSynonymGroupReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddSynonymGroupReply.Unmarshal(m, b)
+}
+func (m *AddSynonymGroupReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddSynonymGroupReply.Marshal(b, m, deterministic)
+}
+func (m *AddSynonymGroupReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddSynonymGroupReply.Merge(m, src)
+}
+func (m *AddSynonymGroupReply) XXX_Size() int {
+	return xxx_messageInfo_AddSynonymGroupReply.Size(m)
+}
+func (m *AddSynonymGroupReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddSynonymGroupReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddSynonymGroupReply proto.InternalMessageInfo
+
+func (m *AddSynonymGroupReply) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type RemoveSynonymGroupRequest struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveSynonymGroupRequest) Reset()         { *m = RemoveSynonymGroupRequest{} }
+func (m *RemoveSynonymGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveSynonymGroupRequest) ProtoMessage()    {}
+func (*RemoveSynonymGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{47}
+}
+
+func (m *RemoveSynonymGroupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveSynonymGroupRequest.Unmarshal(m, b)
+}
+func (m *RemoveSynonymGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveSynonymGroupRequest.Marshal(b, m, deterministic)
+}
+func (m *RemoveSynonymGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveSynonymGroupRequest.Merge(m, src)
+}
+func (m *RemoveSynonymGroupRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveSynonymGroupRequest.Size(m)
+}
+func (m *RemoveSynonymGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveSynonymGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveSynonymGroupRequest proto.InternalMessageInfo
+
+func (m *RemoveSynonymGroupRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type RemoveSynonymGroupReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveSynonymGroupReply) Reset()         { *m = RemoveSynonymGroupReply{} }
+func (m *RemoveSynonymGroupReply) String() string { return proto.CompactTextString(m) }
+func (*RemoveSynonymGroupReply) ProtoMessage()    {}
+func (*RemoveSynonymGroupReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{48}
+}
+
+func (m *RemoveSynonymGroupReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveSynonymGroupReply.Unmarshal(m, b)
+}
+func (m *RemoveSynonymGroupReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveSynonymGroupReply.Marshal(b, m, deterministic)
+}
+func (m *RemoveSynonymGroupReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveSynonymGroupReply.Merge(m, src)
+}
+func (m *RemoveSynonymGroupReply) XXX_Size() int {
+	return xxx_messageInfo_RemoveSynonymGroupReply.Size(m)
+}
+func (m *RemoveSynonymGroupReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveSynonymGroupReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveSynonymGroupReply proto.InternalMessageInfo
+
+type ListSynonymGroupsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListSynonymGroupsRequest) Reset()         { *m = ListSynonymGroupsRequest{} }
+func (m *ListSynonymGroupsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSynonymGroupsRequest) ProtoMessage()    {}
+func (*ListSynonymGroupsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{49}
+}
+
+func (m *ListSynonymGroupsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSynonymGroupsRequest.Unmarshal(m, b)
+}
+func (m *ListSynonymGroupsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSynonymGroupsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListSynonymGroupsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSynonymGroupsRequest.Merge(m, src)
+}
+func (m *ListSynonymGroupsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListSynonymGroupsRequest.Size(m)
+}
+func (m *ListSynonymGroupsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSynonymGroupsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSynonymGroupsRequest proto.InternalMessageInfo
+
+type ListSynonymGroupsReply struct {
+	Groups               []*SynonymGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ListSynonymGroupsReply) Reset()         { *m = ListSynonymGroupsReply{} }
+func (m *ListSynonymGroupsReply) String() string { return proto.CompactTextString(m) }
+func (*ListSynonymGroupsReply) ProtoMessage()    {}
+func (*ListSynonymGroupsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{50}
+}
+
+func (m *ListSynonymGroupsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSynonymGroupsReply.Unmarshal(m, b)
+}
+func (m *ListSynonymGroupsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSynonymGroupsReply.Marshal(b, m, deterministic)
+}
+func (m *ListSynonymGroupsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSynonymGroupsReply.Merge(m, src)
+}
+func (m *ListSynonymGroupsReply) XXX_Size() int {
+	return xxx_messageInfo_ListSynonymGroupsReply.Size(m)
+}
+func (m *ListSynonymGroupsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSynonymGroupsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSynonymGroupsReply proto.InternalMessageInfo
+
+func (m *ListSynonymGroupsReply) GetGroups() []*SynonymGroup {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+type IndexDocumentRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text                 string   `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IndexDocumentRequest) Reset()         { *m = IndexDocumentRequest{} }
+func (m *IndexDocumentRequest) String() string { return proto.CompactTextString(m) }
+func (*IndexDocumentRequest) ProtoMessage()    {}
+func (*IndexDocumentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{51}
+}
+
+func (m *IndexDocumentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexDocumentRequest.Unmarshal(m, b)
+}
+func (m *IndexDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexDocumentRequest.Marshal(b, m, deterministic)
+}
+func (m *IndexDocumentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexDocumentRequest.Merge(m, src)
+}
+func (m *IndexDocumentRequest) XXX_Size() int {
+	return xxx_messageInfo_IndexDocumentRequest.Size(m)
+}
+func (m *IndexDocumentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexDocumentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexDocumentRequest proto.InternalMessageInfo
+
+func (m *IndexDocumentRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *IndexDocumentRequest) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+type IndexDocumentReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IndexDocumentReply) Reset()         { *m = IndexDocumentReply{} }
+func (m *IndexDocumentReply) String() string { return proto.CompactTextString(m) }
+func (*IndexDocumentReply) ProtoMessage()    {}
+func (*IndexDocumentReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{52}
+}
+
+func (m *IndexDocumentReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexDocumentReply.Unmarshal(m, b)
+}
+func (m *IndexDocumentReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexDocumentReply.Marshal(b, m, deterministic)
+}
+func (m *IndexDocumentReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexDocumentReply.Merge(m, src)
+}
+func (m *IndexDocumentReply) XXX_Size() int {
+	return xxx_messageInfo_IndexDocumentReply.Size(m)
+}
+func (m *IndexDocumentReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexDocumentReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexDocumentReply proto.InternalMessageInfo
+
+type DeleteDocumentRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteDocumentRequest) Reset()         { *m = DeleteDocumentRequest{} }
+func (m *DeleteDocumentRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteDocumentRequest) ProtoMessage()    {}
+func (*DeleteDocumentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{53}
+}
+
+func (m *DeleteDocumentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteDocumentRequest.Unmarshal(m, b)
+}
+func (m *DeleteDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteDocumentRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteDocumentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteDocumentRequest.Merge(m, src)
+}
+func (m *DeleteDocumentRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteDocumentRequest.Size(m)
+}
+func (m *DeleteDocumentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteDocumentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteDocumentRequest proto.InternalMessageInfo
+
+func (m *DeleteDocumentRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type DeleteDocumentReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteDocumentReply) Reset()         { *m = DeleteDocumentReply{} }
+func (m *DeleteDocumentReply) String() string { return proto.CompactTextString(m) }
+func (*DeleteDocumentReply) ProtoMessage()    {}
+func (*DeleteDocumentReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{54}
+}
+
+func (m *DeleteDocumentReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteDocumentReply.Unmarshal(m, b)
+}
+func (m *DeleteDocumentReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteDocumentReply.Marshal(b, m, deterministic)
+}
+func (m *DeleteDocumentReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteDocumentReply.Merge(m, src)
+}
+func (m *DeleteDocumentReply) XXX_Size() int {
+	return xxx_messageInfo_DeleteDocumentReply.Size(m)
+}
+func (m *DeleteDocumentReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteDocumentReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteDocumentReply proto.InternalMessageInfo
+
+type SearchDocumentsRequest struct {
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// The most documents to return. Defaults to 10
+	Limit                int32    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchDocumentsRequest) Reset()         { *m = SearchDocumentsRequest{} }
+func (m *SearchDocumentsRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchDocumentsRequest) ProtoMessage()    {}
+func (*SearchDocumentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{55}
+}
+
+func (m *SearchDocumentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchDocumentsRequest.Unmarshal(m, b)
+}
+func (m *SearchDocumentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchDocumentsRequest.Marshal(b, m, deterministic)
+}
+func (m *SearchDocumentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchDocumentsRequest.Merge(m, src)
+}
+func (m *SearchDocumentsRequest) XXX_Size() int {
+	return xxx_messageInfo_SearchDocumentsRequest.Size(m)
+}
+func (m *SearchDocumentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchDocumentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchDocumentsRequest proto.InternalMessageInfo
+
+func (m *SearchDocumentsRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *SearchDocumentsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type DocumentMatch struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Score                float64  `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DocumentMatch) Reset()         { *m = DocumentMatch{} }
+func (m *DocumentMatch) String() string { return proto.CompactTextString(m) }
+func (*DocumentMatch) ProtoMessage()    {}
+func (*DocumentMatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{56}
+}
+
+func (m *DocumentMatch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DocumentMatch.Unmarshal(m, b)
+}
+func (m *DocumentMatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DocumentMatch.Marshal(b, m, deterministic)
+}
+func (m *DocumentMatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocumentMatch.Merge(m, src)
+}
+func (m *DocumentMatch) XXX_Size() int {
+	return xxx_messageInfo_DocumentMatch.Size(m)
+}
+func (m *DocumentMatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocumentMatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DocumentMatch proto.InternalMessageInfo
+
+func (m *DocumentMatch) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DocumentMatch) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+type SearchDocumentsReply struct {
+	// Highest score first
+	Matches              []*DocumentMatch `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SearchDocumentsReply) Reset()         { *m = SearchDocumentsReply{} }
+func (m *SearchDocumentsReply) String() string { return proto.CompactTextString(m) }
+func (*SearchDocumentsReply) ProtoMessage()    {}
+func (*SearchDocumentsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{57}
+}
+
+func (m *SearchDocumentsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchDocumentsReply.Unmarshal(m, b)
+}
+func (m *SearchDocumentsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchDocumentsReply.Marshal(b, m, deterministic)
+}
+func (m *SearchDocumentsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchDocumentsReply.Merge(m, src)
+}
+func (m *SearchDocumentsReply) XXX_Size() int {
+	return xxx_messageInfo_SearchDocumentsReply.Size(m)
+}
+func (m *SearchDocumentsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchDocumentsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchDocumentsReply proto.InternalMessageInfo
+
+func (m *SearchDocumentsReply) GetMatches() []*DocumentMatch {
+	if m != nil {
+		return m.Matches
+	}
+	return nil
+}
+
+type TokenizeTextRequest struct {
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// When true, stopwords are kept rather than left out
+	KeepStopwords bool `protobuf:"varint,2,opt,name=keepStopwords,proto3" json:"keepStopwords,omitempty"`
+	// The stopwords to leave out. Defaults to common English words
+	Stopwords []string `protobuf:"bytes,3,rep,name=stopwords,proto3" json:"stopwords,omitempty"`
+	// When true, each word carries the number of times it appears in the text
+	IncludeFrequencies   bool     `protobuf:"varint,4,opt,name=includeFrequencies,proto3" json:"includeFrequencies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenizeTextRequest) Reset()         { *m = TokenizeTextRequest{} }
+func (m *TokenizeTextRequest) String() string { return proto.CompactTextString(m) }
+func (*TokenizeTextRequest) ProtoMessage()    {}
+func (*TokenizeTextRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{58}
+}
+
+func (m *TokenizeTextRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenizeTextRequest.Unmarshal(m, b)
+}
+func (m *TokenizeTextRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenizeTextRequest.Marshal(b, m, deterministic)
+}
+func (m *TokenizeTextRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeTextRequest.Merge(m, src)
+}
+func (m *TokenizeTextRequest) XXX_Size() int {
+	return xxx_messageInfo_TokenizeTextRequest.Size(m)
+}
+func (m *TokenizeTextRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeTextRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeTextRequest proto.InternalMessageInfo
+
+func (m *TokenizeTextRequest) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *TokenizeTextRequest) GetKeepStopwords() bool {
+	if m != nil {
+		return m.KeepStopwords
+	}
+	return false
+}
+
+func (m *TokenizeTextRequest) GetStopwords() []string {
+	if m != nil {
+		return m.Stopwords
+	}
+	return nil
+}
+
+func (m *TokenizeTextRequest) GetIncludeFrequencies() bool {
+	if m != nil {
+		return m.IncludeFrequencies
+	}
+	return false
+}
+
+type TextWord struct {
+	Word                 string   `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	Frequency            int64    `protobuf:"varint,2,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TextWord) Reset()         { *m = TextWord{} }
+func (m *TextWord) String() string { return proto.CompactTextString(m) }
+func (*TextWord) ProtoMessage()    {}
+func (*TextWord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{59}
+}
+
+func (m *TextWord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TextWord.Unmarshal(m, b)
+}
+func (m *TextWord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TextWord.Marshal(b, m, deterministic)
+}
+func (m *TextWord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TextWord.Merge(m, src)
+}
+func (m *TextWord) XXX_Size() int {
+	return xxx_messageInfo_TextWord.Size(m)
+}
+func (m *TextWord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TextWord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TextWord proto.InternalMessageInfo
+
+func (m *TextWord) GetWord() string {
+	if m != nil {
+		return m.Word
+	}
+	return ""
+}
+
+func (m *TextWord) GetFrequency() int64 {
+	if m != nil {
+		return m.Frequency
+	}
+	return 0
+}
+
+type TokenizeTextReply struct {
+	Words                []*TextWord `protobuf:"bytes,1,rep,name=words,proto3" json:"words,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *TokenizeTextReply) Reset()         { *m = TokenizeTextReply{} }
+func (m *TokenizeTextReply) String() string { return proto.CompactTextString(m) }
+func (*TokenizeTextReply) ProtoMessage()    {}
+func (*TokenizeTextReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{60}
+}
+
+func (m *TokenizeTextReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenizeTextReply.Unmarshal(m, b)
+}
+func (m *TokenizeTextReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenizeTextReply.Marshal(b, m, deterministic)
+}
+func (m *TokenizeTextReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeTextReply.Merge(m, src)
+}
+func (m *TokenizeTextReply) XXX_Size() int {
+	return xxx_messageInfo_TokenizeTextReply.Size(m)
+}
+func (m *TokenizeTextReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeTextReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeTextReply proto.InternalMessageInfo
+
+func (m *TokenizeTextReply) GetWords() []*TextWord {
+	if m != nil {
+		return m.Words
+	}
+	return nil
+}
+
+type AddWordsFromTextRequest struct {
+	Dictionary string `protobuf:"bytes,1,opt,name=dictionary,proto3" json:"dictionary,omitempty"`
+	Text       string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	// As for TokenizeTextRequest
+	KeepStopwords        bool     `protobuf:"varint,3,opt,name=keepStopwords,proto3" json:"keepStopwords,omitempty"`
+	Stopwords            []string `protobuf:"bytes,4,rep,name=stopwords,proto3" json:"stopwords,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddWordsFromTextRequest) Reset()         { *m = AddWordsFromTextRequest{} }
+func (m *AddWordsFromTextRequest) String() string { return proto.CompactTextString(m) }
+func (*AddWordsFromTextRequest) ProtoMessage()    {}
+func (*AddWordsFromTextRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{61}
+}
+
+func (m *AddWordsFromTextRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddWordsFromTextRequest.Unmarshal(m, b)
+}
+func (m *AddWordsFromTextRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddWordsFromTextRequest.Marshal(b, m, deterministic)
+}
+func (m *AddWordsFromTextRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddWordsFromTextRequest.Merge(m, src)
+}
+func (m *AddWordsFromTextRequest) XXX_Size() int {
+	return xxx_messageInfo_AddWordsFromTextRequest.Size(m)
+}
+func (m *AddWordsFromTextRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddWordsFromTextRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddWordsFromTextRequest proto.InternalMessageInfo
+
+func (m *AddWordsFromTextRequest) GetDictionary() string {
+	if m != nil {
+		return m.Dictionary
+	}
+	return ""
+}
+
+func (m *AddWordsFromTextRequest) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *AddWordsFromTextRequest) GetKeepStopwords() bool {
+	if m != nil {
+		return m.KeepStopwords
+	}
+	return false
+}
+
+func (m *AddWordsFromTextRequest) GetStopwords() []string {
+	if m != nil {
+		return m.Stopwords
+	}
+	return nil
+}
+
+type AddWordsFromTextReply struct {
+	Added      int64 `protobuf:"varint,1,opt,name=added,proto3" json:"added,omitempty"`
+	Duplicates int64 `protobuf:"varint,2,opt,name=duplicates,proto3" json:"duplicates,omitempty"`
+	Rejected   int64 `protobuf:"varint,3,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	// The dictionary version the words were added in, or 0 if none were added
+	Version              int64    `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddWordsFromTextReply) Reset()         { *m = AddWordsFromTextReply{} }
+func (m *AddWordsFromTextReply) String() string { return proto.CompactTextString(m) }
+func (*AddWordsFromTextReply) ProtoMessage()    {}
+func (*AddWordsFromTextReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{62}
+}
+
+func (m *AddWordsFromTextReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddWordsFromTextReply.Unmarshal(m, b)
+}
+func (m *AddWordsFromTextReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddWordsFromTextReply.Marshal(b, m, deterministic)
+}
+func (m *AddWordsFromTextReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddWordsFromTextReply.Merge(m, src)
+}
+func (m *AddWordsFromTextReply) XXX_Size() int {
+	return xxx_messageInfo_AddWordsFromTextReply.Size(m)
+}
+func (m *AddWordsFromTextReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddWordsFromTextReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddWordsFromTextReply proto.InternalMessageInfo
+
+func (m *AddWordsFromTextReply) GetAdded() int64 {
+	if m != nil {
+		return m.Added
+	}
+	return 0
+}
+
+func (m *AddWordsFromTextReply) GetDuplicates() int64 {
+	if m != nil {
+		return m.Duplicates
+	}
+	return 0
+}
+
+func (m *AddWordsFromTextReply) GetRejected() int64 {
+	if m != nil {
+		return m.Rejected
+	}
+	return 0
+}
+
+func (m *AddWordsFromTextReply) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type CheckTextRequest struct {
+	Dictionary string `protobuf:"bytes,1,opt,name=dictionary,proto3" json:"dictionary,omitempty"`
+	Text       string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	// The most corrections suggested per word. Defaults to 5
+	MaxSuggestions int32 `protobuf:"varint,3,opt,name=maxSuggestions,proto3" json:"maxSuggestions,omitempty"`
+	// The most insertions, deletions, substitutions and transpositions a correction may be from the misspelled word. Defaults to 2
+	MaxEditDistance      int32    `protobuf:"varint,4,opt,name=maxEditDistance,proto3" json:"maxEditDistance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckTextRequest) Reset()         { *m = CheckTextRequest{} }
+func (m *CheckTextRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckTextRequest) ProtoMessage()    {}
+func (*CheckTextRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{63}
+}
+
+func (m *CheckTextRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckTextRequest.Unmarshal(m, b)
+}
+func (m *CheckTextRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckTextRequest.Marshal(b, m, deterministic)
+}
+func (m *CheckTextRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckTextRequest.Merge(m, src)
+}
+func (m *CheckTextRequest) XXX_Size() int {
+	return xxx_messageInfo_CheckTextRequest.Size(m)
+}
+func (m *CheckTextRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckTextRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckTextRequest proto.InternalMessageInfo
+
+func (m *CheckTextRequest) GetDictionary() string {
+	if m != nil {
+		return m.Dictionary
+	}
+	return ""
+}
+
+func (m *CheckTextRequest) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *CheckTextRequest) GetMaxSuggestions() int32 {
+	if m != nil {
+		return m.MaxSuggestions
+	}
+	return 0
+}
+
+func (m *CheckTextRequest) GetMaxEditDistance() int32 {
+	if m != nil {
+		return m.MaxEditDistance
+	}
+	return 0
+}
+
+type SpellingSuggestion struct {
+	Word                 string   `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	Distance             int32    `protobuf:"varint,2,opt,name=distance,proto3" json:"distance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SpellingSuggestion) Reset()         { *m = SpellingSuggestion{} }
+func (m *SpellingSuggestion) String() string { return proto.CompactTextString(m) }
+func (*SpellingSuggestion) ProtoMessage()    {}
+func (*SpellingSuggestion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{64}
+}
+
+func (m *SpellingSuggestion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SpellingSuggestion.Unmarshal(m, b)
+}
+func (m *SpellingSuggestion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SpellingSuggestion.Marshal(b, m, deterministic)
+}
+func (m *SpellingSuggestion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpellingSuggestion.Merge(m, src)
+}
+func (m *SpellingSuggestion) XXX_Size() int {
+	return xxx_messageInfo_SpellingSuggestion.Size(m)
+}
+func (m *SpellingSuggestion) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpellingSuggestion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpellingSuggestion proto.InternalMessageInfo
+
+func (m *SpellingSuggestion) GetWord() string {
+	if m != nil {
+		return m.Word
+	}
+	return ""
+}
+
+func (m *SpellingSuggestion) GetDistance() int32 {
+	if m != nil {
+		return m.Distance
+	}
+	return 0
+}
+
+// A misspelled word as written in the text. The offsets locate it in the text, and each end is exclusive
+type SpellingIssue struct {
+	Word      string `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	ByteStart int32  `protobuf:"varint,2,opt,name=byteStart,proto3" json:"byteStart,omitempty"`
+	ByteEnd   int32  `protobuf:"varint,3,opt,name=byteEnd,proto3" json:"byteEnd,omitempty"`
+	RuneStart int32  `protobuf:"varint,4,opt,name=runeStart,proto3" json:"runeStart,omitempty"`
+	RuneEnd   int32  `protobuf:"varint,5,opt,name=runeEnd,proto3" json:"runeEnd,omitempty"`
+	// Closest first
+	Suggestions          []*SpellingSuggestion `protobuf:"bytes,6,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *SpellingIssue) Reset()         { *m = SpellingIssue{} }
+func (m *SpellingIssue) String() string { return proto.CompactTextString(m) }
+func (*SpellingIssue) ProtoMessage()    {}
+func (*SpellingIssue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{65}
+}
+
+func (m *SpellingIssue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SpellingIssue.Unmarshal(m, b)
+}
+func (m *SpellingIssue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SpellingIssue.Marshal(b, m, deterministic)
+}
+func (m *SpellingIssue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpellingIssue.Merge(m, src)
+}
+func (m *SpellingIssue) XXX_Size() int {
+	return xxx_messageInfo_SpellingIssue.Size(m)
+}
+func (m *SpellingIssue) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpellingIssue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpellingIssue proto.InternalMessageInfo
+
+func (m *SpellingIssue) GetWord() string {
+	if m != nil {
+		return m.Word
+	}
+	return ""
+}
+
+func (m *SpellingIssue) GetByteStart() int32 {
+	if m != nil {
+		return m.ByteStart
+	}
+	return 0
+}
+
+func (m *SpellingIssue) GetByteEnd() int32 {
+	if m != nil {
+		return m.ByteEnd
+	}
+	return 0
+}
+
+func (m *SpellingIssue) GetRuneStart() int32 {
+	if m != nil {
+		return m.RuneStart
+	}
+	return 0
+}
+
+func (m *SpellingIssue) GetRuneEnd() int32 {
+	if m != nil {
+		return m.RuneEnd
+	}
+	return 0
+}
+
+func (m *SpellingIssue) GetSuggestions() []*SpellingSuggestion {
+	if m != nil {
+		return m.Suggestions
+	}
+	return nil
+}
+
+type CheckTextReply struct {
+	Issues               []*SpellingIssue `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *CheckTextReply) Reset()         { *m = CheckTextReply{} }
+func (m *CheckTextReply) String() string { return proto.CompactTextString(m) }
+func (*CheckTextReply) ProtoMessage()    {}
+func (*CheckTextReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{66}
+}
+
+func (m *CheckTextReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckTextReply.Unmarshal(m, b)
+}
+func (m *CheckTextReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckTextReply.Marshal(b, m, deterministic)
+}
+func (m *CheckTextReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckTextReply.Merge(m, src)
+}
+func (m *CheckTextReply) XXX_Size() int {
+	return xxx_messageInfo_CheckTextReply.Size(m)
+}
+func (m *CheckTextReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckTextReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckTextReply proto.InternalMessageInfo
+
+func (m *CheckTextReply) GetIssues() []*SpellingIssue {
+	if m != nil {
+		return m.Issues
+	}
+	return nil
+}
+
+type AutocompleteRequest struct {
+	Dictionary string `protobuf:"bytes,1,opt,name=dictionary,proto3" json:"dictionary,omitempty"`
+	Prefix     string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// The most completions to return. Defaults to, and is at most, 10
+	Limit                int32    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AutocompleteRequest) Reset()         { *m = AutocompleteRequest{} }
+func (m *AutocompleteRequest) String() string { return proto.CompactTextString(m) }
+func (*AutocompleteRequest) ProtoMessage()    {}
+func (*AutocompleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{67}
+}
+
+func (m *AutocompleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AutocompleteRequest.Unmarshal(m, b)
+}
+func (m *AutocompleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AutocompleteRequest.Marshal(b, m, deterministic)
+}
+func (m *AutocompleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutocompleteRequest.Merge(m, src)
+}
+func (m *AutocompleteRequest) XXX_Size() int {
+	return xxx_messageInfo_AutocompleteRequest.Size(m)
+}
+func (m *AutocompleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutocompleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutocompleteRequest proto.InternalMessageInfo
+
+func (m *AutocompleteRequest) GetDictionary() string {
+	if m != nil {
+		return m.Dictionary
+	}
+	return ""
+}
+
+func (m *AutocompleteRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *AutocompleteRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type Completion struct {
+	Word string `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	// The number of times the word has been searched for
+	SearchCount int64 `protobuf:"varint,2,opt,name=searchCount,proto3" json:"searchCount,omitempty"`
+	// The blend of the word's popularity and brevity the completions are ranked by
+	Score                float64  `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Completion) Reset()         { *m = Completion{} }
+func (m *Completion) String() string { return proto.CompactTextString(m) }
+func (*Completion) ProtoMessage()    {}
+func (*Completion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{68}
+}
+
+func (m *Completion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Completion.Unmarshal(m, b)
+}
+func (m *Completion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Completion.Marshal(b, m, deterministic)
+}
+func (m *Completion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Completion.Merge(m, src)
+}
+func (m *Completion) XXX_Size() int {
+	return xxx_messageInfo_Completion.Size(m)
+}
+func (m *Completion) XXX_DiscardUnknown() {
+	xxx_messageInfo_Completion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Completion proto.InternalMessageInfo
+
+func (m *Completion) GetWord() string {
+	if m != nil {
+		return m.Word
+	}
+	return ""
+}
+
+func (m *Completion) GetSearchCount() int64 {
+	if m != nil {
+		return m.SearchCount
+	}
+	return 0
+}
+
+func (m *Completion) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+type AutocompleteReply struct {
+	Completions          []*Completion `protobuf:"bytes,1,rep,name=completions,proto3" json:"completions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *AutocompleteReply) Reset()         { *m = AutocompleteReply{} }
+func (m *AutocompleteReply) String() string { return proto.CompactTextString(m) }
+func (*AutocompleteReply) ProtoMessage()    {}
+func (*AutocompleteReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{69}
+}
+
+func (m *AutocompleteReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AutocompleteReply.Unmarshal(m, b)
+}
+func (m *AutocompleteReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AutocompleteReply.Marshal(b, m, deterministic)
+}
+func (m *AutocompleteReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutocompleteReply.Merge(m, src)
+}
+func (m *AutocompleteReply) XXX_Size() int {
+	return xxx_messageInfo_AutocompleteReply.Size(m)
+}
+func (m *AutocompleteReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutocompleteReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutocompleteReply proto.InternalMessageInfo
+
+func (m *AutocompleteReply) GetCompletions() []*Completion {
+	if m != nil {
+		return m.Completions
+	}
+	return nil
+}
+
+type PersonalizedSuggestionsRequest struct {
+	Dictionary string `protobuf:"bytes,1,opt,name=dictionary,proto3" json:"dictionary,omitempty"`
+	Prefix     string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// The most suggestions to return. Defaults to 5
+	Limit                int32    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PersonalizedSuggestionsRequest) Reset()         { *m = PersonalizedSuggestionsRequest{} }
+func (m *PersonalizedSuggestionsRequest) String() string { return proto.CompactTextString(m) }
+func (*PersonalizedSuggestionsRequest) ProtoMessage()    {}
+func (*PersonalizedSuggestionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{70}
+}
+
+func (m *PersonalizedSuggestionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PersonalizedSuggestionsRequest.Unmarshal(m, b)
+}
+func (m *PersonalizedSuggestionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PersonalizedSuggestionsRequest.Marshal(b, m, deterministic)
+}
+func (m *PersonalizedSuggestionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PersonalizedSuggestionsRequest.Merge(m, src)
+}
+func (m *PersonalizedSuggestionsRequest) XXX_Size() int {
+	return xxx_messageInfo_PersonalizedSuggestionsRequest.Size(m)
+}
+func (m *PersonalizedSuggestionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PersonalizedSuggestionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PersonalizedSuggestionsRequest proto.InternalMessageInfo
+
+func (m *PersonalizedSuggestionsRequest) GetDictionary() string {
+	if m != nil {
+		return m.Dictionary
+	}
+	return ""
+}
+
+func (m *PersonalizedSuggestionsRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *PersonalizedSuggestionsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type PersonalizedSuggestionsReply struct {
+	// Best first
+	Suggestions          []string `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PersonalizedSuggestionsReply) Reset()         { *m = PersonalizedSuggestionsReply{} }
+func (m *PersonalizedSuggestionsReply) String() string { return proto.CompactTextString(m) }
+func (*PersonalizedSuggestionsReply) ProtoMessage()    {}
+func (*PersonalizedSuggestionsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{71}
+}
+
+func (m *PersonalizedSuggestionsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PersonalizedSuggestionsReply.Unmarshal(m, b)
+}
+func (m *PersonalizedSuggestionsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PersonalizedSuggestionsReply.Marshal(b, m, deterministic)
+}
+func (m *PersonalizedSuggestionsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PersonalizedSuggestionsReply.Merge(m, src)
+}
+func (m *PersonalizedSuggestionsReply) XXX_Size() int {
+	return xxx_messageInfo_PersonalizedSuggestionsReply.Size(m)
+}
+func (m *PersonalizedSuggestionsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_PersonalizedSuggestionsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PersonalizedSuggestionsReply proto.InternalMessageInfo
+
+func (m *PersonalizedSuggestionsReply) GetSuggestions() []string {
+	if m != nil {
+		return m.Suggestions
+	}
+	return nil
+}
+
+type EraseUserHistoryRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EraseUserHistoryRequest) Reset()         { *m = EraseUserHistoryRequest{} }
+func (m *EraseUserHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*EraseUserHistoryRequest) ProtoMessage()    {}
+func (*EraseUserHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{72}
+}
+
+func (m *EraseUserHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EraseUserHistoryRequest.Unmarshal(m, b)
+}
+func (m *EraseUserHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EraseUserHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *EraseUserHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EraseUserHistoryRequest.Merge(m, src)
+}
+func (m *EraseUserHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_EraseUserHistoryRequest.Size(m)
+}
+func (m *EraseUserHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EraseUserHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EraseUserHistoryRequest proto.InternalMessageInfo
+
+func (m *EraseUserHistoryRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type EraseUserHistoryReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EraseUserHistoryReply) Reset()         { *m = EraseUserHistoryReply{} }
+func (m *EraseUserHistoryReply) String() string { return proto.CompactTextString(m) }
+func (*EraseUserHistoryReply) ProtoMessage()    {}
+func (*EraseUserHistoryReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{73}
+}
+
+func (m *EraseUserHistoryReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EraseUserHistoryReply.Unmarshal(m, b)
+}
+func (m *EraseUserHistoryReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EraseUserHistoryReply.Marshal(b, m, deterministic)
+}
+func (m *EraseUserHistoryReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EraseUserHistoryReply.Merge(m, src)
+}
+func (m *EraseUserHistoryReply) XXX_Size() int {
+	return xxx_messageInfo_EraseUserHistoryReply.Size(m)
+}
+func (m *EraseUserHistoryReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_EraseUserHistoryReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EraseUserHistoryReply proto.InternalMessageInfo
+
+type ListQueryRefinementsRequest struct {
+	Dictionary string `protobuf:"bytes,1,opt,name=dictionary,proto3" json:"dictionary,omitempty"`
+	// When not 0, only refinements made at least this many times are listed
+	MinCount int64 `protobuf:"varint,2,opt,name=minCount,proto3" json:"minCount,omitempty"`
+	// When not 0, only refinements within this edit distance are listed, e.g. 2 for likely spelling corrections
+	MaxEditDistance int32 `protobuf:"varint,3,opt,name=maxEditDistance,proto3" json:"maxEditDistance,omitempty"`
+	// When not 0, at most this many refinements are listed
+	Limit                int32    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListQueryRefinementsRequest) Reset()         { *m = ListQueryRefinementsRequest{} }
+func (m *ListQueryRefinementsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListQueryRefinementsRequest) ProtoMessage()    {}
+func (*ListQueryRefinementsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{74}
+}
+
+func (m *ListQueryRefinementsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListQueryRefinementsRequest.Unmarshal(m, b)
+}
+func (m *ListQueryRefinementsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListQueryRefinementsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListQueryRefinementsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListQueryRefinementsRequest.Merge(m, src)
+}
+func (m *ListQueryRefinementsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListQueryRefinementsRequest.Size(m)
+}
+func (m *ListQueryRefinementsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListQueryRefinementsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListQueryRefinementsRequest proto.InternalMessageInfo
+
+func (m *ListQueryRefinementsRequest) GetDictionary() string {
+	if m != nil {
+		return m.Dictionary
+	}
+	return ""
+}
+
+func (m *ListQueryRefinementsRequest) GetMinCount() int64 {
+	if m != nil {
+		return m.MinCount
+	}
+	return 0
+}
+
+func (m *ListQueryRefinementsRequest) GetMaxEditDistance() int32 {
+	if m != nil {
+		return m.MaxEditDistance
+	}
+	return 0
+}
+
+func (m *ListQueryRefinementsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type QueryRefinement struct {
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// The number of times to was searched for straight after from
+	Count                int64    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	EditDistance         int32    `protobuf:"varint,4,opt,name=editDistance,proto3" json:"editDistance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryRefinement) Reset()         { *m = QueryRefinement{} }
+func (m *QueryRefinement) String() string { return proto.CompactTextString(m) }
+func (*QueryRefinement) ProtoMessage()    {}
+func (*QueryRefinement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{75}
+}
+
+func (m *QueryRefinement) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryRefinement.Unmarshal(m, b)
+}
+func (m *QueryRefinement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryRefinement.Marshal(b, m, deterministic)
+}
+func (m *QueryRefinement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRefinement.Merge(m, src)
+}
+func (m *QueryRefinement) XXX_Size() int {
+	return xxx_messageInfo_QueryRefinement.Size(m)
+}
+func (m *QueryRefinement) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRefinement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRefinement proto.InternalMessageInfo
+
+func (m *QueryRefinement) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *QueryRefinement) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *QueryRefinement) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *QueryRefinement) GetEditDistance() int32 {
+	if m != nil {
+		return m.EditDistance
+	}
+	return 0
+}
+
+type ListQueryRefinementsReply struct {
+	Refinements          []*QueryRefinement `protobuf:"bytes,1,rep,name=refinements,proto3" json:"refinements,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ListQueryRefinementsReply) Reset()         { *m = ListQueryRefinementsReply{} }
+func (m *ListQueryRefinementsReply) String() string { return proto.CompactTextString(m) }
+func (*ListQueryRefinementsReply) ProtoMessage()    {}
+func (*ListQueryRefinementsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ae0e577dd3a98ef, []int{76}
+}
+
+func (m *ListQueryRefinementsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListQueryRefinementsReply.Unmarshal(m, b)
+}
+func (m *ListQueryRefinementsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListQueryRefinementsReply.Marshal(b, m, deterministic)
+}
+func (m *ListQueryRefinementsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListQueryRefinementsReply.Merge(m, src)
+}
+func (m *ListQueryRefinementsReply) XXX_Size() int {
+	return xxx_messageInfo_ListQueryRefinementsReply.Size(m)
+}
+func (m *ListQueryRefinementsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListQueryRefinementsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListQueryRefinementsReply proto.InternalMessageInfo
+
+func (m *ListQueryRefinementsReply) GetRefinements() []*QueryRefinement {
+	if m != nil {
+		return m.Refinements
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("wordsearchsystemgrpc.MatchMode", MatchMode_name, MatchMode_value)
+	proto.RegisterType((*SearchWordRequest)(nil), "wordsearchsystemgrpc.SearchWordRequest")
+	proto.RegisterType((*SearchWordReply)(nil), "wordsearchsystemgrpc.SearchWordReply")
+	proto.RegisterType((*SearchMatch)(nil), "wordsearchsystemgrpc.SearchMatch")
+	proto.RegisterType((*HighlightSpan)(nil), "wordsearchsystemgrpc.HighlightSpan")
+	proto.RegisterType((*WordMetadata)(nil), "wordsearchsystemgrpc.WordMetadata")
+	proto.RegisterMapType((map[string]string)(nil), "wordsearchsystemgrpc.WordMetadata.AttributesEntry")
+	proto.RegisterType((*AddWordsRequest)(nil), "wordsearchsystemgrpc.AddWordsRequest")
+	proto.RegisterType((*AddWordsReply)(nil), "wordsearchsystemgrpc.AddWordsReply")
+	proto.RegisterType((*Top5SearchKeyWordsRequest)(nil), "wordsearchsystemgrpc.Top5SearchKeyWordsRequest")
+	proto.RegisterType((*Top5SearchKeyWordsReply)(nil), "wordsearchsystemgrpc.Top5SearchKeyWordsReply")
+	proto.RegisterType((*ExportWordsRequest)(nil), "wordsearchsystemgrpc.ExportWordsRequest")
+	proto.RegisterType((*ExportWordsReply)(nil), "wordsearchsystemgrpc.ExportWordsReply")
+	proto.RegisterType((*AddWordsStreamRequest)(nil), "wordsearchsystemgrpc.AddWordsStreamRequest")
+	proto.RegisterType((*AddWordsStreamReply)(nil), "wordsearchsystemgrpc.AddWordsStreamReply")
+	proto.RegisterType((*CreateDictionaryRequest)(nil), "wordsearchsystemgrpc.CreateDictionaryRequest")
+	proto.RegisterType((*CreateDictionaryReply)(nil), "wordsearchsystemgrpc.CreateDictionaryReply")
+	proto.RegisterType((*ListDictionariesRequest)(nil), "wordsearchsystemgrpc.ListDictionariesRequest")
+	proto.RegisterType((*ListDictionariesReply)(nil), "wordsearchsystemgrpc.ListDictionariesReply")
+	proto.RegisterType((*DeleteDictionaryRequest)(nil), "wordsearchsystemgrpc.DeleteDictionaryRequest")
+	proto.RegisterType((*DeleteDictionaryReply)(nil), "wordsearchsystemgrpc.DeleteDictionaryReply")
+	proto.RegisterType((*ListVersionsRequest)(nil), "wordsearchsystemgrpc.ListVersionsRequest")
+	proto.RegisterType((*ListVersionsReply)(nil), "wordsearchsystemgrpc.ListVersionsReply")
+	proto.RegisterType((*DictionaryVersion)(nil), "wordsearchsystemgrpc.DictionaryVersion")
+	proto.RegisterType((*RollbackDictionaryRequest)(nil), "wordsearchsystemgrpc.RollbackDictionaryRequest")
+	proto.RegisterType((*RollbackDictionaryReply)(nil), "wordsearchsystemgrpc.RollbackDictionaryReply")
+	proto.RegisterType((*WordEntry)(nil), "wordsearchsystemgrpc.WordEntry")
+	proto.RegisterType((*UpsertWordsRequest)(nil), "wordsearchsystemgrpc.UpsertWordsRequest")
+	proto.RegisterType((*UpsertWordsReply)(nil), "wordsearchsystemgrpc.UpsertWordsReply")
+	proto.RegisterType((*ValidateWordsRequest)(nil), "wordsearchsystemgrpc.ValidateWordsRequest")
+	proto.RegisterType((*ValidateWordsReply)(nil), "wordsearchsystemgrpc.ValidateWordsReply")
+	proto.RegisterType((*WordViolation)(nil), "wordsearchsystemgrpc.WordViolation")
+	proto.RegisterType((*AddBlockedTermsRequest)(nil), "wordsearchsystemgrpc.AddBlockedTermsRequest")
+	proto.RegisterType((*AddBlockedTermsReply)(nil), "wordsearchsystemgrpc.AddBlockedTermsReply")
+	proto.RegisterType((*RemoveBlockedTermsRequest)(nil), "wordsearchsystemgrpc.RemoveBlockedTermsRequest")
+	proto.RegisterType((*RemoveBlockedTermsReply)(nil), "wordsearchsystemgrpc.RemoveBlockedTermsReply")
+	proto.RegisterType((*ListBlockedTermsRequest)(nil), "wordsearchsystemgrpc.ListBlockedTermsRequest")
+	proto.RegisterType((*ListBlockedTermsReply)(nil), "wordsearchsystemgrpc.ListBlockedTermsReply")
+	proto.RegisterType((*ListAuditEventsRequest)(nil), "wordsearchsystemgrpc.ListAuditEventsRequest")
+	proto.RegisterType((*AuditWordChange)(nil), "wordsearchsystemgrpc.AuditWordChange")
+	proto.RegisterType((*AuditEvent)(nil), "wordsearchsystemgrpc.AuditEvent")
+	proto.RegisterType((*ListAuditEventsReply)(nil), "wordsearchsystemgrpc.ListAuditEventsReply")
+	proto.RegisterType((*SearchWordStreamRequest)(nil), "wordsearchsystemgrpc.SearchWordStreamRequest")
+	proto.RegisterType((*SearchWordStreamReply)(nil), "wordsearchsystemgrpc.SearchWordStreamReply")
+	proto.RegisterType((*QueryWordsRequest)(nil), "wordsearchsystemgrpc.QueryWordsRequest")
+	proto.RegisterType((*QueryWordsReply)(nil), "wordsearchsystemgrpc.QueryWordsReply")
+	proto.RegisterType((*SynonymGroup)(nil), "wordsearchsystemgrpc.SynonymGroup")
+	proto.RegisterType((*AddSynonymGroupRequest)(nil), "wordsearchsystemgrpc.AddSynonymGroupRequest")
+	proto.RegisterType((*AddSynonymGroupReply)(nil), "wordsearchsystemgrpc.AddSynonymGroupReply")
+	proto.RegisterType((*RemoveSynonymGroupRequest)(nil), "wordsearchsystemgrpc.RemoveSynonymGroupRequest")
+	proto.RegisterType((*RemoveSynonymGroupReply)(nil), "wordsearchsystemgrpc.RemoveSynonymGroupReply")
+	proto.RegisterType((*ListSynonymGroupsRequest)(nil), "wordsearchsystemgrpc.ListSynonymGroupsRequest")
+	proto.RegisterType((*ListSynonymGroupsReply)(nil), "wordsearchsystemgrpc.ListSynonymGroupsReply")
+	proto.RegisterType((*IndexDocumentRequest)(nil), "wordsearchsystemgrpc.IndexDocumentRequest")
+	proto.RegisterType((*IndexDocumentReply)(nil), "wordsearchsystemgrpc.IndexDocumentReply")
+	proto.RegisterType((*DeleteDocumentRequest)(nil), "wordsearchsystemgrpc.DeleteDocumentRequest")
+	proto.RegisterType((*DeleteDocumentReply)(nil), "wordsearchsystemgrpc.DeleteDocumentReply")
+	proto.RegisterType((*SearchDocumentsRequest)(nil), "wordsearchsystemgrpc.SearchDocumentsRequest")
+	proto.RegisterType((*DocumentMatch)(nil), "wordsearchsystemgrpc.DocumentMatch")
+	proto.RegisterType((*SearchDocumentsReply)(nil), "wordsearchsystemgrpc.SearchDocumentsReply")
+	proto.RegisterType((*TokenizeTextRequest)(nil), "wordsearchsystemgrpc.TokenizeTextRequest")
+	proto.RegisterType((*TextWord)(nil), "wordsearchsystemgrpc.TextWord")
+	proto.RegisterType((*TokenizeTextReply)(nil), "wordsearchsystemgrpc.TokenizeTextReply")
+	proto.RegisterType((*AddWordsFromTextRequest)(nil), "wordsearchsystemgrpc.AddWordsFromTextRequest")
+	proto.RegisterType((*AddWordsFromTextReply)(nil), "wordsearchsystemgrpc.AddWordsFromTextReply")
+	proto.RegisterType((*CheckTextRequest)(nil), "wordsearchsystemgrpc.CheckTextRequest")
+	proto.RegisterType((*SpellingSuggestion)(nil), "wordsearchsystemgrpc.SpellingSuggestion")
+	proto.RegisterType((*SpellingIssue)(nil), "wordsearchsystemgrpc.SpellingIssue")
+	proto.RegisterType((*CheckTextReply)(nil), "wordsearchsystemgrpc.CheckTextReply")
+	proto.RegisterType((*AutocompleteRequest)(nil), "wordsearchsystemgrpc.AutocompleteRequest")
+	proto.RegisterType((*Completion)(nil), "wordsearchsystemgrpc.Completion")
+	proto.RegisterType((*AutocompleteReply)(nil), "wordsearchsystemgrpc.AutocompleteReply")
+	proto.RegisterType((*PersonalizedSuggestionsRequest)(nil), "wordsearchsystemgrpc.PersonalizedSuggestionsRequest")
+	proto.RegisterType((*PersonalizedSuggestionsReply)(nil), "wordsearchsystemgrpc.PersonalizedSuggestionsReply")
+	proto.RegisterType((*EraseUserHistoryRequest)(nil), "wordsearchsystemgrpc.EraseUserHistoryRequest")
+	proto.RegisterType((*EraseUserHistoryReply)(nil), "wordsearchsystemgrpc.EraseUserHistoryReply")
+	proto.RegisterType((*ListQueryRefinementsRequest)(nil), "wordsearchsystemgrpc.ListQueryRefinementsRequest")
+	proto.RegisterType((*QueryRefinement)(nil), "wordsearchsystemgrpc.QueryRefinement")
+	proto.RegisterType((*ListQueryRefinementsReply)(nil), "wordsearchsystemgrpc.ListQueryRefinementsReply")
+}
+
+func init() { proto.RegisterFile("word_search_system_grpc.proto", fileDescriptor_1ae0e577dd3a98ef) }
+
+var fileDescriptor_1ae0e577dd3a98ef = []byte{
+	// 2919 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x19, 0x5d, 0x73, 0xdb, 0xc6,
+	0x31, 0x20, 0x45, 0x99, 0x5c, 0x5a, 0x1f, 0x3e, 0xc9, 0x12, 0x85, 0xba, 0x8e, 0x7a, 0x89, 0x6d,
+	0x5a, 0xb2, 0xe5, 0x44, 0x89, 0xa7, 0x99, 0xa4, 0x69, 0x4b, 0x7d, 0xc4, 0x56, 0x13, 0x25, 0x0e,
+	0x28, 0x27, 0x9e, 0xe9, 0x4c, 0x3c, 0x10, 0x70, 0xa2, 0x10, 0x81, 0x00, 0x02, 0x1c, 0x15, 0x32,
+	0xd3, 0xb7, 0x36, 0x3f, 0xa0, 0x6f, 0x6d, 0x67, 0xfa, 0x17, 0xfa, 0x33, 0xfa, 0xd4, 0xfe, 0x85,
+	0xb6, 0x4f, 0x7d, 0xef, 0x63, 0xdf, 0x3a, 0x77, 0x87, 0x8f, 0xc3, 0x17, 0x09, 0xa5, 0x93, 0xbc,
+	0x61, 0x17, 0xbb, 0xb7, 0x7b, 0xfb, 0x75, 0xb7, 0x7b, 0xf0, 0xe3, 0xaf, 0x5d, 0xdf, 0x7c, 0x19,
+	0x10, 0xdd, 0x37, 0xce, 0x5f, 0x06, 0x93, 0x80, 0x92, 0xe1, 0xcb, 0x81, 0xef, 0x19, 0x3b, 0x9e,
+	0xef, 0x52, 0x17, 0xad, 0xb2, 0xdf, 0xe2, 0xaf, 0xf8, 0xc9, 0xfe, 0xa9, 0xb7, 0x07, 0xae, 0x3b,
+	0xb0, 0xc9, 0x23, 0x4e, 0x73, 0x3a, 0x3a, 0x7b, 0x64, 0x8e, 0x7c, 0x9d, 0x5a, 0xae, 0x23, 0xb8,
+	0xd4, 0x57, 0xb3, 0xff, 0xa9, 0x35, 0x24, 0x01, 0xd5, 0x87, 0x9e, 0x20, 0xc0, 0xff, 0xa8, 0xc3,
+	0x8d, 0x3e, 0x5f, 0xf5, 0x73, 0xd7, 0x37, 0x35, 0xf2, 0xd5, 0x88, 0x04, 0x14, 0x75, 0xe0, 0xda,
+	0x05, 0x99, 0x30, 0x4c, 0x47, 0xd9, 0x54, 0xba, 0x2d, 0x2d, 0x02, 0xd1, 0x6d, 0x00, 0xd3, 0x32,
+	0x98, 0x04, 0xdd, 0x9f, 0x74, 0x6a, 0xfc, 0xa7, 0x84, 0x61, 0x9c, 0x97, 0xc4, 0x0f, 0x2c, 0xd7,
+	0xe9, 0xd4, 0x37, 0x95, 0x6e, 0x5d, 0x8b, 0x40, 0xd4, 0x85, 0x25, 0xcb, 0x31, 0xec, 0x91, 0x49,
+	0x8e, 0x09, 0xd5, 0x4d, 0x9d, 0xea, 0x9d, 0xb9, 0x4d, 0xa5, 0xdb, 0xd4, 0xb2, 0x68, 0x84, 0xe1,
+	0xba, 0xa7, 0xfb, 0xf4, 0x93, 0xb3, 0xbe, 0x47, 0x88, 0x71, 0xde, 0x69, 0x70, 0x29, 0x29, 0x1c,
+	0x42, 0x30, 0x47, 0xf5, 0x41, 0xd0, 0x99, 0xdf, 0xac, 0x77, 0x5b, 0x1a, 0xff, 0x46, 0x2a, 0x34,
+	0x3d, 0x7d, 0x40, 0xfa, 0xd6, 0x37, 0xa4, 0x73, 0x6d, 0x53, 0xe9, 0x36, 0xb4, 0x18, 0x46, 0xb7,
+	0xa0, 0xc5, 0xbe, 0x4f, 0xdc, 0x0b, 0xe2, 0x74, 0x9a, 0x7c, 0xc1, 0x04, 0xc1, 0x74, 0xf3, 0x75,
+	0xe7, 0x62, 0x6f, 0xa2, 0x11, 0x9b, 0x5c, 0xea, 0x8e, 0x41, 0x3a, 0x2d, 0xa1, 0x5b, 0x06, 0x8d,
+	0x1e, 0xc0, 0x8d, 0x50, 0xdd, 0xa7, 0xd6, 0xe0, 0xdc, 0xb6, 0x06, 0xe7, 0x34, 0xe8, 0x00, 0xa7,
+	0xcd, 0xff, 0x60, 0x1a, 0x31, 0x57, 0x0d, 0x2d, 0x67, 0xd0, 0x69, 0x73, 0xa2, 0x18, 0x46, 0x77,
+	0x61, 0x91, 0x8c, 0x3d, 0xdd, 0x31, 0xfb, 0x13, 0xc7, 0x75, 0x26, 0xc3, 0xa0, 0x73, 0x9d, 0x53,
+	0x64, 0xb0, 0xe8, 0x7d, 0x68, 0x0d, 0x75, 0x6a, 0x9c, 0x1f, 0xbb, 0x26, 0xe9, 0x2c, 0x6c, 0x2a,
+	0xdd, 0xc5, 0xdd, 0x57, 0x77, 0x8a, 0x82, 0x61, 0xe7, 0x38, 0x22, 0xd3, 0x12, 0x0e, 0xfc, 0x37,
+	0x05, 0x96, 0x64, 0x07, 0x7b, 0x36, 0x77, 0x12, 0x27, 0x20, 0x41, 0x47, 0xe1, 0xf6, 0x8b, 0x40,
+	0xd9, 0x7d, 0xb5, 0xb4, 0xfb, 0xde, 0x83, 0x6b, 0x3e, 0x09, 0x46, 0x36, 0x0d, 0x3a, 0xf5, 0xcd,
+	0x7a, 0xb7, 0xbd, 0xfb, 0x93, 0x62, 0x25, 0x84, 0x2c, 0xae, 0x8a, 0x16, 0x71, 0xa0, 0xd7, 0x61,
+	0xc1, 0x21, 0x63, 0xfa, 0x2c, 0xf6, 0xc0, 0x1c, 0xf7, 0x40, 0x1a, 0xc9, 0xfc, 0x4e, 0x5d, 0xaa,
+	0xdb, 0xc7, 0xa1, 0x6e, 0x0d, 0xee, 0xc3, 0x14, 0x0e, 0xff, 0x4b, 0x81, 0xb6, 0x24, 0x82, 0xc5,
+	0xc1, 0xd7, 0x49, 0x98, 0xf2, 0x6f, 0xf4, 0x73, 0x68, 0x0e, 0xa3, 0x10, 0x63, 0xbb, 0x68, 0xef,
+	0xe2, 0x62, 0x5d, 0x99, 0x45, 0xa2, 0xa8, 0xd3, 0x62, 0x1e, 0xb4, 0x0a, 0x8d, 0xc0, 0x70, 0x7d,
+	0xc2, 0x23, 0x58, 0xd1, 0x04, 0x80, 0xf6, 0x01, 0xce, 0x13, 0x97, 0xcf, 0x71, 0x1b, 0xbc, 0x56,
+	0xbc, 0x6e, 0x1c, 0x01, 0x7d, 0x4f, 0x77, 0x34, 0x89, 0x8d, 0x6d, 0x51, 0xb8, 0x97, 0x98, 0x1f,
+	0xf8, 0xee, 0x30, 0x0a, 0x6d, 0x19, 0x87, 0x7f, 0x0a, 0x0b, 0xa9, 0x05, 0xb8, 0x3e, 0x54, 0xf7,
+	0x29, 0xdf, 0x64, 0x43, 0x13, 0x00, 0x5a, 0x86, 0x3a, 0x71, 0x4c, 0xbe, 0xc1, 0x86, 0xc6, 0x3e,
+	0xf1, 0x7f, 0x15, 0xb8, 0x2e, 0x6f, 0x89, 0x27, 0x2b, 0x39, 0xb3, 0x1c, 0x8b, 0x65, 0x67, 0x68,
+	0x22, 0x09, 0x93, 0x4b, 0xb4, 0xda, 0x94, 0x44, 0xab, 0x4b, 0x89, 0xa6, 0x01, 0xe8, 0x94, 0xfa,
+	0xd6, 0xe9, 0x88, 0x92, 0xc8, 0x14, 0xbb, 0xb3, 0x4d, 0xbc, 0xd3, 0x8b, 0x99, 0x0e, 0x1d, 0xea,
+	0x4f, 0x34, 0x69, 0x15, 0xf5, 0x7d, 0x58, 0xca, 0xfc, 0x66, 0x3b, 0xbc, 0x20, 0x93, 0x50, 0x6f,
+	0xf6, 0xc9, 0x2c, 0x71, 0xa9, 0xdb, 0x23, 0x12, 0x6a, 0x2a, 0x80, 0x77, 0x6b, 0xef, 0x28, 0xf8,
+	0x2f, 0x0a, 0x2c, 0xf5, 0x4c, 0x93, 0x89, 0x0b, 0xa2, 0x2a, 0xb6, 0x0a, 0x0d, 0xae, 0x53, 0x18,
+	0xe4, 0x02, 0x98, 0x59, 0xc1, 0xde, 0x81, 0x16, 0x19, 0x7b, 0x96, 0x4f, 0x82, 0x1e, 0xe5, 0x11,
+	0xd0, 0xde, 0x55, 0x77, 0x44, 0x19, 0xdd, 0x89, 0xca, 0xe8, 0xce, 0x49, 0x54, 0x46, 0xb5, 0x84,
+	0x18, 0x6d, 0x43, 0x9d, 0x52, 0x9b, 0xc7, 0x76, 0x7b, 0x77, 0x23, 0xc7, 0x73, 0x10, 0x96, 0x66,
+	0x8d, 0x51, 0xe1, 0xfb, 0xb0, 0x90, 0xe8, 0x1b, 0x26, 0x65, 0x94, 0x7a, 0x4a, 0x2a, 0xf5, 0xf0,
+	0x7b, 0xb0, 0x71, 0xe2, 0x7a, 0x8f, 0x45, 0xd8, 0x7f, 0x48, 0x26, 0xa9, 0x4d, 0xa6, 0xb7, 0xa3,
+	0x64, 0xb7, 0x83, 0x1f, 0xc3, 0x7a, 0x11, 0x33, 0x93, 0xa8, 0x42, 0xf3, 0x82, 0x4c, 0x64, 0x13,
+	0xc5, 0x30, 0xfe, 0x12, 0xd0, 0xe1, 0xd8, 0x73, 0x7d, 0x7a, 0x15, 0x61, 0x68, 0x0d, 0xe6, 0xcf,
+	0x5c, 0x7f, 0xa8, 0xd3, 0xd0, 0xae, 0x21, 0xc4, 0xaa, 0xaf, 0x71, 0x3e, 0x72, 0x2e, 0x78, 0x69,
+	0xae, 0xf3, 0x88, 0x4d, 0x10, 0xb8, 0x0b, 0xcb, 0x29, 0x59, 0x4c, 0xb7, 0x55, 0x68, 0x70, 0x02,
+	0x2e, 0xe4, 0xba, 0x26, 0x00, 0x7c, 0x0c, 0x37, 0x23, 0xa3, 0xf5, 0xa9, 0x4f, 0xf4, 0x61, 0x55,
+	0xc5, 0xe2, 0x50, 0xa8, 0x49, 0xa1, 0x80, 0x07, 0xb0, 0x92, 0x5d, 0x2e, 0x94, 0xad, 0x9b, 0x26,
+	0x31, 0x43, 0x3f, 0x08, 0x80, 0x8b, 0x18, 0x79, 0xb6, 0x65, 0xe8, 0x2c, 0xe8, 0x45, 0x75, 0x94,
+	0x30, 0xcc, 0x9a, 0x3e, 0xf9, 0x92, 0x18, 0x94, 0x98, 0xe1, 0xd1, 0x17, 0xc3, 0xf8, 0x21, 0xac,
+	0xef, 0xfb, 0x44, 0xa7, 0xe4, 0x20, 0x56, 0x29, 0xd2, 0x1c, 0xc1, 0x9c, 0xa3, 0x0f, 0x49, 0x54,
+	0xc0, 0xd8, 0x37, 0x5e, 0x87, 0x9b, 0x79, 0x72, 0xcf, 0x9e, 0xe0, 0x0d, 0x58, 0xff, 0xc8, 0x0a,
+	0x68, 0x8c, 0xb6, 0x48, 0xe4, 0x1a, 0xfc, 0x10, 0x6e, 0xe6, 0x7f, 0x85, 0xbb, 0x61, 0x8b, 0xc6,
+	0x59, 0xc0, 0x01, 0xa6, 0xd1, 0x01, 0xb1, 0xc9, 0x15, 0x34, 0xca, 0x93, 0x33, 0x8d, 0x1e, 0xc3,
+	0x0a, 0x13, 0xfb, 0x99, 0x08, 0xd5, 0xca, 0x51, 0xf9, 0x02, 0x6e, 0xa4, 0xd9, 0x98, 0xa6, 0xfb,
+	0xd0, 0x0c, 0x43, 0x5e, 0x28, 0xdb, 0xde, 0xbd, 0x57, 0x5c, 0x54, 0x12, 0x25, 0xc2, 0x05, 0xb4,
+	0x98, 0x11, 0xff, 0x47, 0x81, 0x1b, 0xb9, 0xff, 0xe5, 0xc9, 0xc5, 0xd2, 0xdd, 0xe0, 0xb6, 0x36,
+	0x7b, 0xb4, 0x53, 0x9b, 0x9d, 0xee, 0x31, 0x31, 0xda, 0x84, 0xb6, 0x49, 0x02, 0xc3, 0xb7, 0x3c,
+	0x1a, 0x5d, 0x77, 0x5a, 0x9a, 0x8c, 0x62, 0x56, 0xe0, 0xfa, 0xf7, 0x78, 0x34, 0xcd, 0xf1, 0xb8,
+	0x97, 0x30, 0xac, 0xfe, 0x72, 0xe8, 0xb9, 0x67, 0xb2, 0x35, 0xa3, 0x03, 0x4f, 0xc6, 0xc5, 0x34,
+	0x1a, 0x19, 0xba, 0x97, 0xc4, 0xec, 0xcc, 0x4b, 0x34, 0x21, 0x0e, 0x3f, 0x87, 0x0d, 0xcd, 0xb5,
+	0xed, 0x53, 0xdd, 0xb8, 0xc8, 0xbb, 0x73, 0x56, 0x6a, 0x94, 0x1e, 0xf9, 0xf8, 0x2d, 0x58, 0x2f,
+	0x5a, 0x76, 0x7a, 0xb1, 0xfa, 0xab, 0x02, 0x2d, 0x96, 0x51, 0xa2, 0x84, 0x7f, 0x1f, 0xc7, 0xf3,
+	0x0f, 0x54, 0xa0, 0x2f, 0x00, 0x3d, 0xf7, 0x02, 0x72, 0xc5, 0x0a, 0xf8, 0x58, 0x2e, 0x34, 0xed,
+	0xb2, 0x9b, 0x5a, 0x6c, 0xa0, 0xa8, 0x12, 0x3d, 0x80, 0xe5, 0x94, 0xb0, 0xe9, 0x36, 0xfe, 0x08,
+	0x56, 0x3f, 0xd3, 0x6d, 0x8b, 0x05, 0xc8, 0x95, 0x94, 0x2b, 0xae, 0x82, 0xdf, 0x2a, 0x80, 0x32,
+	0xcb, 0x89, 0x6c, 0x84, 0x4b, 0xcb, 0xb5, 0x75, 0x2a, 0xe5, 0xe3, 0x6b, 0xe5, 0xdb, 0xf9, 0x2c,
+	0xa2, 0xd5, 0x24, 0x36, 0xb4, 0x05, 0xcb, 0x64, 0x6c, 0x10, 0x62, 0x06, 0x8c, 0xe6, 0x23, 0x6b,
+	0x68, 0x89, 0x24, 0x6b, 0x6a, 0x39, 0x3c, 0xfe, 0x14, 0x16, 0x52, 0x0b, 0x31, 0x75, 0x2d, 0xc7,
+	0x24, 0xe3, 0xe8, 0xde, 0xc3, 0x81, 0x38, 0xa4, 0x6a, 0x52, 0x48, 0xad, 0xc1, 0xbc, 0x4f, 0xf4,
+	0x20, 0xce, 0xc2, 0x10, 0xc2, 0x3b, 0xb0, 0xd6, 0x33, 0xcd, 0x3d, 0xdb, 0x35, 0x2e, 0x88, 0x79,
+	0x42, 0xfc, 0xa1, 0x7c, 0x37, 0xa0, 0x0c, 0x8e, 0xaa, 0x22, 0x07, 0xf0, 0x1a, 0xac, 0xe6, 0xe8,
+	0x59, 0x95, 0x7b, 0x13, 0x36, 0x44, 0xae, 0x55, 0x5f, 0x6a, 0x03, 0xd6, 0x8b, 0x58, 0xa4, 0x2a,
+	0x5e, 0xb0, 0x56, 0x54, 0xc5, 0x73, 0x3c, 0x25, 0x42, 0xfe, 0x54, 0x83, 0x35, 0x46, 0xdf, 0x1b,
+	0x99, 0x16, 0x3d, 0xbc, 0x24, 0x0e, 0xbd, 0xca, 0x51, 0x6d, 0xe8, 0xb6, 0x4d, 0xfc, 0xe8, 0xa8,
+	0x16, 0x10, 0xc3, 0xeb, 0x86, 0x54, 0xd0, 0x42, 0x28, 0x36, 0xfb, 0x9c, 0x64, 0xf6, 0x37, 0xa0,
+	0x11, 0x58, 0xac, 0x59, 0x6a, 0xcc, 0xcc, 0x42, 0x41, 0xc8, 0x38, 0x46, 0x0e, 0xb5, 0xec, 0xce,
+	0xfc, 0x6c, 0x0e, 0x4e, 0xf8, 0xdd, 0x9b, 0x3a, 0x3c, 0x84, 0x25, 0x6e, 0x17, 0x16, 0x54, 0xfb,
+	0xe7, 0xba, 0x33, 0x20, 0x85, 0xe5, 0xe8, 0x75, 0x58, 0x20, 0x63, 0x2b, 0xa0, 0xc4, 0xdc, 0x23,
+	0x67, 0xec, 0xd6, 0x2f, 0xe2, 0x33, 0x8d, 0x64, 0xc5, 0x9e, 0x23, 0x82, 0xde, 0x19, 0x25, 0x3e,
+	0xb7, 0x4d, 0x53, 0x93, 0x51, 0xf8, 0xef, 0x35, 0x80, 0xc4, 0x0f, 0x68, 0x11, 0x6a, 0x56, 0x74,
+	0x83, 0xa8, 0x59, 0x26, 0xda, 0x81, 0x39, 0xd6, 0x7b, 0x57, 0x38, 0x62, 0x38, 0x9d, 0xe4, 0x9f,
+	0x7a, 0xca, 0x3f, 0x9b, 0xd0, 0xf6, 0x08, 0xf1, 0x7b, 0xa6, 0xe9, 0x93, 0x20, 0x08, 0xdd, 0x21,
+	0xa3, 0x98, 0x55, 0x7c, 0x11, 0x04, 0x47, 0x66, 0xd8, 0x60, 0x24, 0x88, 0x4c, 0x5c, 0xcc, 0x17,
+	0xc5, 0x45, 0xe8, 0xff, 0x6b, 0x29, 0xff, 0x4b, 0xd5, 0xa8, 0x99, 0x3e, 0x41, 0x7f, 0x01, 0xd7,
+	0x0c, 0x6e, 0xde, 0xa0, 0xd3, 0xe2, 0x55, 0xe2, 0x4e, 0x71, 0x95, 0xc8, 0x38, 0x43, 0x8b, 0xb8,
+	0x58, 0x6c, 0x13, 0xdf, 0x77, 0x7d, 0xde, 0x47, 0xb7, 0x34, 0x01, 0xe0, 0x4b, 0x58, 0xcd, 0x85,
+	0x36, 0xcb, 0x84, 0x77, 0x60, 0x9e, 0x70, 0x30, 0xac, 0x49, 0x9b, 0x53, 0xa4, 0x71, 0x3e, 0x2d,
+	0xa4, 0xcf, 0x77, 0xa1, 0xb5, 0x82, 0x2e, 0x14, 0xff, 0xae, 0x0e, 0xeb, 0x49, 0xc3, 0x9c, 0xbe,
+	0x66, 0x7e, 0xf7, 0xb9, 0xc8, 0x2d, 0x68, 0x9d, 0xb2, 0x86, 0x55, 0xbe, 0x01, 0xc7, 0x88, 0x1f,
+	0x60, 0x36, 0x52, 0x38, 0xb7, 0xb8, 0x56, 0x65, 0x6e, 0xd1, 0x9c, 0x39, 0xb7, 0x68, 0xcd, 0x9e,
+	0x5b, 0xc0, 0x95, 0xe7, 0x16, 0x0e, 0xdc, 0xcc, 0x7b, 0x61, 0xfa, 0xf0, 0x42, 0x1a, 0x51, 0xd4,
+	0xae, 0x3a, 0xa2, 0xc0, 0x47, 0x70, 0xe3, 0xd3, 0x11, 0xf1, 0x27, 0xd9, 0x0e, 0xf2, 0x2b, 0x86,
+	0x0c, 0xbd, 0x2d, 0x80, 0x59, 0xbe, 0xc6, 0xdb, 0xb0, 0x24, 0x2f, 0x35, 0x55, 0x69, 0xfc, 0x36,
+	0x5c, 0x0f, 0x4d, 0xf6, 0xc4, 0x77, 0x47, 0x5e, 0xae, 0x6e, 0x14, 0x9f, 0xd9, 0xe2, 0x60, 0x93,
+	0x19, 0xa7, 0x36, 0xbd, 0xf8, 0x2e, 0xac, 0xe6, 0xe8, 0x99, 0x5e, 0x19, 0x69, 0x78, 0x3b, 0x3a,
+	0xe8, 0x8a, 0x96, 0xce, 0x12, 0xc7, 0x47, 0x5c, 0x6e, 0x5d, 0xac, 0x42, 0x87, 0x25, 0xaf, 0xfc,
+	0x23, 0x3e, 0xe3, 0x4e, 0x60, 0xad, 0xe0, 0x1f, 0xd3, 0xe6, 0x5d, 0x98, 0x1f, 0x70, 0x30, 0x4c,
+	0xed, 0x92, 0x7b, 0x61, 0x4a, 0x5c, 0xc8, 0x81, 0xdf, 0x85, 0xd5, 0x23, 0x76, 0x3f, 0x38, 0x70,
+	0x8d, 0xd1, 0x90, 0x65, 0x7d, 0x4e, 0xe9, 0x16, 0xb7, 0x27, 0x4b, 0x0e, 0x32, 0x8e, 0x1a, 0x54,
+	0xfe, 0x8d, 0x57, 0x01, 0x65, 0x78, 0xd9, 0x1e, 0xee, 0xc5, 0x3d, 0xcf, 0xf4, 0x25, 0xf1, 0x4d,
+	0x58, 0xc9, 0x12, 0x32, 0xfe, 0x03, 0x58, 0x13, 0x91, 0x16, 0xa1, 0x67, 0x84, 0xd5, 0x2a, 0x34,
+	0xec, 0xf8, 0x82, 0xd4, 0xd0, 0x04, 0x80, 0x1f, 0xc3, 0x42, 0xc4, 0x2f, 0x26, 0x5e, 0xd9, 0x0d,
+	0xc5, 0xd3, 0xaa, 0x9a, 0x34, 0xad, 0xc2, 0xcf, 0x61, 0x35, 0x27, 0x9c, 0x99, 0xf8, 0xfd, 0x74,
+	0x20, 0x96, 0x5e, 0xe9, 0x52, 0x32, 0x93, 0x68, 0xfd, 0xb3, 0x02, 0x2b, 0xbc, 0x4c, 0x5a, 0xdf,
+	0x90, 0x13, 0x32, 0xa6, 0x52, 0xcf, 0xc8, 0xad, 0xaa, 0x24, 0x56, 0x65, 0xe5, 0xf6, 0x82, 0x10,
+	0xaf, 0x4f, 0x5d, 0x2f, 0x8a, 0x60, 0x7e, 0xb0, 0xa6, 0x90, 0xac, 0x30, 0x06, 0x31, 0x85, 0x18,
+	0x32, 0x25, 0x08, 0xb4, 0x03, 0x28, 0xac, 0x4e, 0x1f, 0xf0, 0x23, 0xcc, 0x31, 0x2c, 0x12, 0x84,
+	0xb5, 0xb1, 0xe0, 0x0f, 0xfe, 0x19, 0x34, 0x99, 0x5a, 0xbc, 0x24, 0x17, 0x1d, 0xf6, 0xb7, 0xa0,
+	0x75, 0x16, 0x92, 0x4f, 0xc2, 0x76, 0x27, 0x41, 0xb0, 0x1a, 0x90, 0xde, 0x1c, 0xb3, 0xd8, 0xdb,
+	0x72, 0x42, 0xb5, 0x77, 0x6f, 0x17, 0xdb, 0x2b, 0x92, 0x1a, 0x25, 0xdc, 0xef, 0x15, 0x58, 0x8f,
+	0x66, 0x0b, 0x6c, 0xaa, 0x27, 0x1b, 0x6b, 0xd6, 0xd5, 0xac, 0x20, 0x44, 0xf3, 0xc6, 0xac, 0xcf,
+	0x34, 0xe6, 0x5c, 0xc6, 0x98, 0xf8, 0xb7, 0x0a, 0xdc, 0xcc, 0xeb, 0xf4, 0xbd, 0x4c, 0x3c, 0xe4,
+	0xeb, 0xc2, 0x5c, 0xba, 0x79, 0xf9, 0x83, 0x02, 0xcb, 0xfb, 0xe7, 0xc4, 0xb8, 0xf8, 0x7f, 0x4d,
+	0x72, 0x17, 0x16, 0x87, 0xfa, 0xb8, 0x3f, 0x1a, 0x0c, 0x48, 0x20, 0x9a, 0x14, 0x71, 0xae, 0x66,
+	0xb0, 0xec, 0x70, 0x1d, 0xea, 0xe3, 0x43, 0xd3, 0xa2, 0x07, 0x56, 0x40, 0xf9, 0x70, 0x5f, 0xb4,
+	0xe2, 0x59, 0x34, 0x3e, 0x00, 0xd4, 0xf7, 0x88, 0x6d, 0x5b, 0xce, 0x20, 0x59, 0xa0, 0x30, 0x8e,
+	0x54, 0x68, 0x9a, 0xd1, 0x62, 0x22, 0x5d, 0x63, 0x18, 0xff, 0x53, 0x81, 0x85, 0x68, 0x99, 0xa3,
+	0x20, 0x18, 0x91, 0xb2, 0x48, 0x3c, 0x9d, 0x50, 0xd2, 0xe7, 0x83, 0xdd, 0x5a, 0x78, 0x21, 0x88,
+	0x10, 0xcc, 0x7c, 0x0c, 0x38, 0x74, 0xcc, 0x70, 0x53, 0x11, 0xc8, 0xf8, 0xfc, 0x91, 0x13, 0xf2,
+	0x89, 0x7d, 0x24, 0x08, 0xc6, 0xc7, 0x00, 0xc6, 0x27, 0x86, 0x09, 0x11, 0x88, 0x7e, 0x05, 0xed,
+	0x40, 0x32, 0xd5, 0x3c, 0x0f, 0xe6, 0x6e, 0x49, 0x81, 0xcd, 0x19, 0x41, 0x93, 0x99, 0xf1, 0x31,
+	0x2c, 0x4a, 0x1e, 0x64, 0x01, 0xf4, 0x1e, 0xcc, 0x5b, 0x6c, 0xab, 0x33, 0xaa, 0x4a, 0xca, 0x2c,
+	0x5a, 0xc8, 0x82, 0x0d, 0x58, 0xe9, 0x8d, 0xa8, 0x6b, 0xb8, 0x43, 0x8f, 0x55, 0xd1, 0x2b, 0x74,
+	0x30, 0x9e, 0x4f, 0xce, 0xac, 0x71, 0xd4, 0xc1, 0x08, 0x28, 0xa9, 0xa3, 0x75, 0xb9, 0x8e, 0xbe,
+	0x00, 0xd8, 0x17, 0x02, 0xca, 0x7c, 0xba, 0x09, 0x6d, 0xa1, 0xf0, 0xbe, 0x3b, 0x72, 0x68, 0x18,
+	0xef, 0x32, 0xaa, 0xf8, 0x61, 0x00, 0x7f, 0x0e, 0x37, 0xd2, 0xea, 0x33, 0x83, 0xec, 0x41, 0xdb,
+	0x88, 0xc5, 0xcd, 0xb8, 0xaa, 0x26, 0x7a, 0x69, 0x32, 0x13, 0x76, 0xe0, 0xf6, 0x33, 0xe2, 0x07,
+	0xae, 0xa3, 0xdb, 0xd6, 0x37, 0xc4, 0x94, 0x62, 0xfa, 0xfb, 0x31, 0xd1, 0x2f, 0xe1, 0x56, 0xa9,
+	0x3c, 0xb6, 0xa7, 0xcd, 0x74, 0x08, 0x89, 0x0b, 0x46, 0x2a, 0x30, 0xde, 0x84, 0xf5, 0x43, 0x5f,
+	0x0f, 0xc8, 0xf3, 0x80, 0xf8, 0x4f, 0xad, 0x80, 0xba, 0xc9, 0x18, 0x6a, 0x0d, 0xe6, 0x47, 0x01,
+	0xf1, 0x8f, 0x22, 0x9b, 0x87, 0x10, 0x9b, 0x2c, 0xe6, 0x59, 0xd8, 0xf1, 0xf9, 0x47, 0x05, 0x7e,
+	0xc4, 0xee, 0x09, 0xfc, 0x2a, 0xa5, 0xb1, 0x47, 0x0b, 0x32, 0xbc, 0x4a, 0x83, 0xab, 0x42, 0x73,
+	0x68, 0x39, 0xb2, 0x2f, 0x63, 0xb8, 0xa8, 0x24, 0xd4, 0x0b, 0x4b, 0x42, 0x62, 0xa9, 0x39, 0xd9,
+	0x52, 0x2e, 0x2c, 0x65, 0xd4, 0x62, 0x11, 0x75, 0xc6, 0x5e, 0x74, 0xc2, 0x88, 0x62, 0xdf, 0xec,
+	0xa8, 0xa6, 0x6e, 0x68, 0xfa, 0x1a, 0x7b, 0xc3, 0x85, 0x86, 0xc1, 0xf5, 0x11, 0xd5, 0x52, 0x00,
+	0xfc, 0x4d, 0x28, 0x5f, 0x9c, 0x52, 0x38, 0x6c, 0xc2, 0x46, 0xb1, 0x2d, 0x98, 0x5f, 0x9e, 0x40,
+	0xdb, 0x4f, 0x70, 0x1d, 0x65, 0x5a, 0x13, 0x96, 0x59, 0x41, 0x93, 0x39, 0xb7, 0x3e, 0x85, 0x56,
+	0x7c, 0x17, 0x47, 0x2b, 0xb0, 0x74, 0xdc, 0x3b, 0xd9, 0x7f, 0xfa, 0xb2, 0xff, 0x7c, 0xaf, 0x7f,
+	0xa2, 0x1d, 0x7d, 0xfc, 0x64, 0xf9, 0x15, 0xb4, 0x0e, 0x2b, 0x02, 0xf9, 0xec, 0xa9, 0xd6, 0xeb,
+	0x1f, 0xbe, 0x7c, 0xa6, 0x1d, 0x7e, 0x70, 0xf4, 0x62, 0x59, 0x41, 0x6b, 0x80, 0xc4, 0x8f, 0x93,
+	0x4f, 0x3e, 0x3c, 0xfc, 0x38, 0xc2, 0xd7, 0x76, 0xff, 0xad, 0xc2, 0x32, 0xbf, 0xc1, 0x73, 0x45,
+	0xfa, 0x5c, 0x11, 0xf4, 0x05, 0x40, 0x72, 0xb7, 0x47, 0xf7, 0xa6, 0xdd, 0xd2, 0xa5, 0x57, 0x69,
+	0xf5, 0xce, 0x6c, 0x42, 0x16, 0x38, 0xaf, 0xa0, 0x17, 0xd0, 0x8c, 0xce, 0x39, 0x54, 0xd6, 0x8c,
+	0xa6, 0xdf, 0x8a, 0xd4, 0xd7, 0x66, 0x91, 0x89, 0x95, 0x2f, 0x01, 0xe5, 0x5f, 0x53, 0xd0, 0xa3,
+	0x92, 0x3b, 0x41, 0xd9, 0xa3, 0x8d, 0xfa, 0xb0, 0x3a, 0x83, 0x90, 0x6b, 0x40, 0x5b, 0x7a, 0x22,
+	0x41, 0x25, 0x75, 0x3b, 0xff, 0x62, 0xa3, 0xde, 0xad, 0x40, 0xc9, 0x45, 0xbc, 0xa1, 0x20, 0x1b,
+	0x16, 0xd3, 0xcf, 0x21, 0x68, 0x7b, 0xba, 0x55, 0x52, 0xcd, 0xb1, 0x7a, 0xbf, 0x1a, 0x31, 0x97,
+	0xd6, 0x55, 0x90, 0x07, 0xcb, 0xd9, 0x47, 0x0e, 0x54, 0x62, 0x97, 0x92, 0xb7, 0x13, 0x75, 0xbb,
+	0x2a, 0xb9, 0x30, 0xa2, 0x07, 0xcb, 0xd9, 0x27, 0x92, 0x32, 0x89, 0x25, 0xaf, 0x2c, 0xea, 0x76,
+	0x55, 0xf2, 0x58, 0x62, 0xf6, 0xd9, 0xa4, 0x4c, 0x62, 0xc9, 0x6b, 0x8c, 0xba, 0x5d, 0x95, 0x5c,
+	0x48, 0x34, 0xe1, 0xba, 0xfc, 0xb0, 0x82, 0xee, 0x97, 0x2b, 0x9c, 0x79, 0xb3, 0x51, 0xef, 0x55,
+	0x21, 0x8d, 0xd3, 0x20, 0xff, 0x32, 0x50, 0x96, 0x06, 0xa5, 0x4f, 0x13, 0xea, 0xc3, 0xea, 0x0c,
+	0x42, 0xae, 0x0e, 0x6d, 0x69, 0x4c, 0x5e, 0x96, 0x06, 0xf9, 0xb1, 0xbd, 0x7a, 0xb7, 0x02, 0xa5,
+	0x10, 0x31, 0x80, 0x85, 0xd4, 0x30, 0x1c, 0x6d, 0x15, 0xb3, 0x16, 0x0d, 0xe0, 0xd5, 0x6e, 0x25,
+	0x5a, 0x21, 0x68, 0xc8, 0x1f, 0xac, 0xe5, 0x49, 0x2f, 0x7a, 0x50, 0x9a, 0x41, 0x05, 0xb3, 0x62,
+	0x75, 0xab, 0x22, 0x75, 0xe2, 0xb2, 0xdc, 0x3c, 0xba, 0xd4, 0x65, 0x65, 0xc3, 0x6e, 0xf5, 0x61,
+	0x75, 0x86, 0x54, 0xd2, 0xa5, 0xa4, 0x4e, 0x49, 0xba, 0x22, 0x99, 0xdb, 0x55, 0xc9, 0x63, 0xc3,
+	0x66, 0x06, 0x87, 0x65, 0x86, 0x2d, 0x1e, 0x9d, 0xab, 0x5b, 0x15, 0xa9, 0x85, 0x38, 0x1f, 0x96,
+	0xb3, 0x83, 0xaa, 0xb2, 0x0d, 0x96, 0x8c, 0x15, 0xd5, 0xed, 0xaa, 0xe4, 0x51, 0xa5, 0xfe, 0x02,
+	0x20, 0x99, 0x30, 0x95, 0x1d, 0xa0, 0xb9, 0x71, 0x96, 0x7a, 0x67, 0x36, 0xa1, 0x1c, 0x9b, 0xa9,
+	0xb9, 0x54, 0x79, 0x6c, 0x16, 0x8c, 0x8a, 0xd4, 0xad, 0x8a, 0xd4, 0x99, 0xd8, 0x4c, 0x49, 0x9c,
+	0x1a, 0x9b, 0x45, 0x42, 0x1f, 0x56, 0x67, 0x10, 0x72, 0x03, 0xf1, 0x0a, 0x2d, 0xff, 0x0a, 0xd0,
+	0x4e, 0xb9, 0xf7, 0x8b, 0xc6, 0x59, 0xea, 0x83, 0xca, 0xf4, 0x71, 0x81, 0x49, 0x0d, 0x9b, 0xca,
+	0x0a, 0x4c, 0xd1, 0x34, 0x4b, 0xed, 0x56, 0xa2, 0x15, 0x82, 0xbe, 0x84, 0xc5, 0xf4, 0x58, 0x0a,
+	0x4d, 0x3f, 0x4b, 0x32, 0xa2, 0xee, 0x57, 0x23, 0x8e, 0x03, 0x26, 0x33, 0x6e, 0x2a, 0x0b, 0x98,
+	0xe2, 0x91, 0x98, 0xba, 0x55, 0x91, 0x3a, 0x3e, 0xe5, 0xe4, 0x41, 0x4d, 0xd9, 0x29, 0x57, 0x30,
+	0xa9, 0x52, 0xef, 0x55, 0x21, 0x8d, 0x4b, 0x57, 0x76, 0x5c, 0x52, 0x96, 0xd9, 0x25, 0xa3, 0x1e,
+	0x75, 0xbb, 0x2a, 0xb9, 0x90, 0xf8, 0x6b, 0x68, 0xc5, 0x8d, 0x35, 0x2a, 0x39, 0xb3, 0xb2, 0xb3,
+	0x13, 0xf5, 0xf5, 0x99, 0x74, 0xb1, 0xd1, 0xe4, 0x3e, 0xb5, 0xcc, 0x68, 0x05, 0xad, 0xb8, 0x7a,
+	0xaf, 0x0a, 0xa9, 0x90, 0xf2, 0xad, 0x02, 0xeb, 0x25, 0x5d, 0x24, 0x7a, 0xbb, 0x78, 0x99, 0xe9,
+	0x4d, 0xae, 0xba, 0x7b, 0x45, 0xae, 0xd8, 0x79, 0xd9, 0xbe, 0xb2, 0xcc, 0x79, 0x25, 0x2d, 0xab,
+	0xba, 0x5d, 0x95, 0x5c, 0x48, 0xfc, 0x8d, 0x78, 0xb0, 0xca, 0xf6, 0x68, 0xe8, 0xcd, 0xf2, 0x02,
+	0x51, 0xd2, 0xdb, 0xaa, 0x8f, 0xae, 0xc2, 0xc2, 0xa5, 0xef, 0x1d, 0xc0, 0x1d, 0xcb, 0xdd, 0xe1,
+	0x64, 0x64, 0xac, 0x33, 0x9f, 0x04, 0x85, 0x8b, 0xec, 0x6d, 0x64, 0xdb, 0xb1, 0x27, 0xbe, 0x67,
+	0x3c, 0xf3, 0x5d, 0xea, 0x3e, 0x53, 0x4e, 0xe7, 0xf9, 0x7b, 0xe4, 0x5b, 0xff, 0x1b, 0x00, 0x3a,
+	0x91, 0xf7, 0xd4, 0x8d, 0x2c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// WordSearchSystemClient is the client API for WordSearchSystem service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WordSearchSystemClient interface {
+	// Sends a greeting
+	SearchWord(ctx context.Context, in *SearchWordRequest, opts ...grpc.CallOption) (*SearchWordReply, error)
+	AddWords(ctx context.Context, in *AddWordsRequest, opts ...grpc.CallOption) (*AddWordsReply, error)
+	Top5SearchKeyWords(ctx context.Context, in *Top5SearchKeyWordsRequest, opts ...grpc.CallOption) (*Top5SearchKeyWordsReply, error)
+	// Streams the whole dictionary in alphabetical order, in chunks
+	ExportWords(ctx context.Context, in *ExportWordsRequest, opts ...grpc.CallOption) (WordSearchSystem_ExportWordsClient, error)
+	// Adds the words of a stream in batches, counting rather than refusing duplicate and invalid words
+	AddWordsStream(ctx context.Context, opts ...grpc.CallOption) (WordSearchSystem_AddWordsStreamClient, error)
+	CreateDictionary(ctx context.Context, in *CreateDictionaryRequest, opts ...grpc.CallOption) (*CreateDictionaryReply, error)
+	ListDictionaries(ctx context.Context, in *ListDictionariesRequest, opts ...grpc.CallOption) (*ListDictionariesReply, error)
+	// Deletes a dictionary along with its words and keyword statistics. The default dictionary cannot be deleted
+	DeleteDictionary(ctx context.Context, in *DeleteDictionaryRequest, opts ...grpc.CallOption) (*DeleteDictionaryReply, error)
+	// Lists the versions kept for a dictionary, oldest first
+	ListVersions(ctx context.Context, in *ListVersionsRequest, opts ...grpc.CallOption) (*ListVersionsReply, error)
+	// Atomically restores the words of an earlier version. The rollback produces a new version
+	RollbackDictionary(ctx context.Context, in *RollbackDictionaryRequest, opts ...grpc.CallOption) (*RollbackDictionaryReply, error)
+	// Adds each word with its metadata, or replaces the metadata of words which already exist
+	UpsertWords(ctx context.Context, in *UpsertWordsRequest, opts ...grpc.CallOption) (*UpsertWordsReply, error)
+	// A dry run of AddWords, which reports the words which would be refused without adding any
+	ValidateWords(ctx context.Context, in *ValidateWordsRequest, opts ...grpc.CallOption) (*ValidateWordsReply, error)
+	// Blocked terms cannot be added to any of the tenant's dictionaries, and are hidden from search results and keyword rankings
+	AddBlockedTerms(ctx context.Context, in *AddBlockedTermsRequest, opts ...grpc.CallOption) (*AddBlockedTermsReply, error)
+	RemoveBlockedTerms(ctx context.Context, in *RemoveBlockedTermsRequest, opts ...grpc.CallOption) (*RemoveBlockedTermsReply, error)
+	ListBlockedTerms(ctx context.Context, in *ListBlockedTermsRequest, opts ...grpc.CallOption) (*ListBlockedTermsReply, error)
+	// Lists the mutations made to the tenant's dictionaries, oldest first
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsReply, error)
+	// Streams the matches for a keyword in alphabetical batches as the dictionary is scanned
+	SearchWordStream(ctx context.Context, in *SearchWordStreamRequest, opts ...grpc.CallOption) (WordSearchSystem_SearchWordStreamClient, error)
+	// Returns the words matching a query such as "pre* AND NOT *fix" or "(cat OR dog) len:3-5"
+	QueryWords(ctx context.Context, in *QueryWordsRequest, opts ...grpc.CallOption) (*QueryWordsReply, error)
+	// Synonym groups are shared by all of the tenant's dictionaries, and expand searches which ask for it
+	AddSynonymGroup(ctx context.Context, in *AddSynonymGroupRequest, opts ...grpc.CallOption) (*AddSynonymGroupReply, error)
+	RemoveSynonymGroup(ctx context.Context, in *RemoveSynonymGroupRequest, opts ...grpc.CallOption) (*RemoveSynonymGroupReply, error)
+	ListSynonymGroups(ctx context.Context, in *ListSynonymGroupsRequest, opts ...grpc.CallOption) (*ListSynonymGroupsReply, error)
+	// Documents are indexed for full-text search, ranked by BM25. Indexing a document with an id already in use replaces it
+	IndexDocument(ctx context.Context, in *IndexDocumentRequest, opts ...grpc.CallOption) (*IndexDocumentReply, error)
+	DeleteDocument(ctx context.Context, in *DeleteDocumentRequest, opts ...grpc.CallOption) (*DeleteDocumentReply, error)
+	SearchDocuments(ctx context.Context, in *SearchDocumentsRequest, opts ...grpc.CallOption) (*SearchDocumentsReply, error)
+	// Returns the distinct words of a text in the order they first appear, normalized the same way as AddWords normalizes words
+	TokenizeText(ctx context.Context, in *TokenizeTextRequest, opts ...grpc.CallOption) (*TokenizeTextReply, error)
+	// Adds the distinct words of a text. Unlike AddWords, words which already exist or are refused are counted in the reply rather than failing the request
+	AddWordsFromText(ctx context.Context, in *AddWordsFromTextRequest, opts ...grpc.CallOption) (*AddWordsFromTextReply, error)
+	// Returns every word of a text which is not in the dictionary, in the order they appear, with ranked corrections
+	CheckText(ctx context.Context, in *CheckTextRequest, opts ...grpc.CallOption) (*CheckTextReply, error)
+	// Returns the dictionary words starting with a prefix, the most searched for first. Autocompleting does not count as searching for the prefix
+	Autocomplete(ctx context.Context, in *AutocompleteRequest, opts ...grpc.CallOption) (*AutocompleteReply, error)
+	// Suggests keywords starting with a prefix for the user named by the user-id metadata, blending the user's own searches with every user's searches
+	PersonalizedSuggestions(ctx context.Context, in *PersonalizedSuggestionsRequest, opts ...grpc.CallOption) (*PersonalizedSuggestionsReply, error)
+	// Forgets a user's searches in all of the tenant's dictionaries, and removes them from the query log.
+	// Requires one of the tenant's admin API keys, as the user-id metadata is set by the client and so cannot show the caller is the user
+	EraseUserHistory(ctx context.Context, in *EraseUserHistoryRequest, opts ...grpc.CallOption) (*EraseUserHistoryReply, error)
+	// Lists the keywords searched for within a minute of a different keyword in the same search session, named by the session-id metadata, the most frequent first
+	ListQueryRefinements(ctx context.Context, in *ListQueryRefinementsRequest, opts ...grpc.CallOption) (*ListQueryRefinementsReply, error)
+}
+
+type wordSearchSystemClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewWordSearchSystemClient(cc *grpc.ClientConn) WordSearchSystemClient {
+	return &wordSearchSystemClient{cc}
+}
+
+func (c *wordSearchSystemClient) SearchWord(ctx context.Context, in *SearchWordRequest, opts ...grpc.CallOption) (*SearchWordReply, error) {
+	out := new(SearchWordReply)
+	err := c.cc.Invoke(ctx, "/wordsearchsystemgrpc.WordSearchSystem/SearchWord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordSearchSystemClient) AddWords(ctx context.Context, in *AddWordsRequest, opts ...grpc.CallOption) (*AddWordsReply, error) {
+	out := new(AddWordsReply)
+	err := c.cc.Invoke(ctx, "/wordsearchsystemgrpc.WordSearchSystem/AddWords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordSearchSystemClient) Top5SearchKeyWords(ctx context.Context, in *Top5SearchKeyWordsRequest, opts ...grpc.CallOption) (*Top5SearchKeyWordsReply, error) {
+	out := new(Top5SearchKeyWordsReply)
+	err := c.cc.Invoke(ctx, "/wordsearchsystemgrpc.WordSearchSystem/Top5SearchKeyWords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordSearchSystemClient) ExportWords(ctx context.Context, in *ExportWordsRequest, opts ...grpc.CallOption) (WordSearchSystem_ExportWordsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WordSearchSystem_serviceDesc.Streams[0], "/wordsearchsystemgrpc.WordSearchSystem/ExportWords", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &wordSearchSystemExportWordsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WordSearchSystem_ExportWordsClient interface {
+	Recv() (*ExportWordsReply, error)
+	grpc.ClientStream
+}
+
+type wordSearchSystemExportWordsClient struct {
+	grpc.ClientStream
+}
+
+func (x *wordSearchSystemExportWordsClient) Recv() (*ExportWordsReply, error) {
+	m := new(ExportWordsReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *wordSearchSystemClient) AddWordsStream(ctx context.Context, opts ...grpc.CallOption) (WordSearchSystem_AddWordsStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WordSearchSystem_serviceDesc.Streams[1], "/wordsearchsystemgrpc.WordSearchSystem/AddWordsStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &wordSearchSystemAddWordsStreamClient{stream}
+	return x, nil
+}
+
+type WordSearchSystem_AddWordsStreamClient interface {
+	Send(*AddWordsStreamRequest) error
+	CloseAndRecv() (*AddWordsStreamReply, error)
+	grpc.ClientStream
+}
+
+type wordSearchSystemAddWordsStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *wordSearchSystemAddWordsStreamClient) Send(m *AddWordsStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *wordSearchSystemAddWordsStreamClient) CloseAndRecv() (*AddWordsStreamReply, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(AddWordsStreamReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *wordSearchSystemClient) CreateDictionary(ctx context.Context, in *CreateDictionaryRequest, opts ...grpc.CallOption) (*CreateDictionaryReply, error) {
+	out := new(CreateDictionaryReply)
+	err := c.cc.Invoke(ctx, "/wordsearchsystemgrpc.WordSearchSystem/CreateDictionary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordSearchSystemClient) ListDictionaries(ctx context.Context, in *ListDictionariesRequest, opts ...grpc.CallOption) (*ListDictionariesReply, error) {
+	out := new(ListDictionariesReply)
+	err := c.cc.Invoke(ctx, "/wordsearchsystemgrpc.WordSearchSystem/ListDictionaries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordSearchSystemClient) DeleteDictionary(ctx context.Context, in *DeleteDictionaryRequest, opts ...grpc.CallOption) (*DeleteDictionaryReply, error) {
+	out := new(DeleteDictionaryReply)
+	err := c.cc.Invoke(ctx, "/wordsearchsystemgrpc.WordSearchSystem/DeleteDictionary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordSearchSystemClient) ListVersions(ctx context.Context, in *ListVersionsRequest, opts ...grpc.CallOption) (*ListVersionsReply, error) {
+	out := new(ListVersionsReply)
+	err := c.cc.Invoke(ctx, "/wordsearchsystemgrpc.WordSearchSystem/ListVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordSearchSystemClient) RollbackDictionary(ctx context.Context, in *RollbackDictionaryRequest, opts ...grpc.CallOption) (*RollbackDictionaryReply, error) {
+	out := new(RollbackDictionaryReply)
+	err := c.cc.Invoke(ctx, "/wordsearchsystemgrpc.WordSearchSystem/RollbackDictionary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordSearchSystemClient) UpsertWords(ctx context.Context, in *UpsertWordsRequest, opts ...grpc.CallOption) (*UpsertWordsReply, error) {
+	out := new(UpsertWordsReply)
+	err := c.cc.Invoke(ctx, "/wordsearchsystemgrpc.WordSearchSystem/UpsertWords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordSearchSystemClient) ValidateWords(ctx context.Context, in *ValidateWordsRequest, opts ...grpc.CallOption) (*ValidateWordsReply, error) {
+	out := new(ValidateWordsReply)
+	err := c.cc.Invoke(ctx, "/wordsearchsystemgrpc.WordSearchSystem/ValidateWords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordSearchSystemClient) AddBlockedTerms(ctx context.Context, in *AddBlockedTermsRequest, opts ...grpc.CallOption) (*AddBlockedTermsReply, error) {
+	out := new(AddBlockedTermsReply)
+	err := c.cc.Invoke(ctx, "/wordsearchsystemgrpc.WordSearchSystem/AddBlockedTerms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordSearchSystemClient) RemoveBlockedTerms(ctx context.Context, in *RemoveBlockedTermsRequest, opts ...grpc.CallOption) (*RemoveBlockedTermsReply, error) {
+	out := new(RemoveBlockedTermsReply)
+	err := c.cc.Invoke(ctx, "/wordsearchsystemgrpc.WordSearchSystem/RemoveBlockedTerms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordSearchSystemClient) ListBlockedTerms(ctx context.Context, in *ListBlockedTermsRequest, opts ...grpc.CallOption) (*ListBlockedTermsReply, error) {
+	out := new(ListBlockedTermsReply)
+	err := c.cc.Invoke(ctx, "/wordsearchsystemgrpc.WordSearchSystem/ListBlockedTerms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordSearchSystemClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsReply, error) {
+	out := new(ListAuditEventsReply)
+	err := c.cc.Invoke(ctx, "/wordsearchsystemgrpc.WordSearchSystem/ListAuditEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordSearchSystemClient) SearchWordStream(ctx context.Context, in *SearchWordStreamRequest, opts ...grpc.CallOption) (WordSearchSystem_SearchWordStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WordSearchSystem_serviceDesc.Streams[2], "/wordsearchsystemgrpc.WordSearchSystem/SearchWordStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &wordSearchSystemSearchWordStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WordSearchSystem_SearchWordStreamClient interface {
+	Recv() (*SearchWordStreamReply, error)
+	grpc.ClientStream
+}
+
+type wordSearchSystemSearchWordStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *wordSearchSystemSearchWordStreamClient) Recv() (*SearchWordStreamReply, error) {
+	m := new(SearchWordStreamReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *wordSearchSystemClient) QueryWords(ctx context.Context, in *QueryWordsRequest, opts ...grpc.CallOption) (*QueryWordsReply, error) {
+	out := new(QueryWordsReply)
+	err := c.cc.Invoke(ctx, "/wordsearchsystemgrpc.WordSearchSystem/QueryWords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordSearchSystemClient) AddSynonymGroup(ctx context.Context, in *AddSynonymGroupRequest, opts ...grpc.CallOption) (*AddSynonymGroupReply, error) {
+	out := new(AddSynonymGroupReply)
+	err := c.cc.Invoke(ctx, "/wordsearchsystemgrpc.WordSearchSystem/AddSynonymGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordSearchSystemClient) RemoveSynonymGroup(ctx context.Context, in *RemoveSynonymGroupRequest, opts ...grpc.CallOption) (*RemoveSynonymGroupReply, error) {
+	out := new(RemoveSynonymGroupReply)
+	err := c.cc.Invoke(ctx, "/wordsearchsystemgrpc.WordSearchSystem/RemoveSynonymGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordSearchSystemClient) ListSynonymGroups(ctx context.Context, in *ListSynonymGroupsRequest, opts ...grpc.CallOption) (*ListSynonymGroupsReply, error) {
+	out := new(ListSynonymGroupsReply)
+	err := c.cc.Invoke(ctx, "/wordsearchsystemgrpc.WordSearchSystem/ListSynonymGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordSearchSystemClient) IndexDocument(ctx context.Context, in *IndexDocumentRequest, opts ...grpc.CallOption) (*IndexDocumentReply, error) {
+	out := new(IndexDocumentReply)
+	err := c.cc.Invoke(ctx, "/wordsearchsystemgrpc.WordSearchSystem/IndexDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordSearchSystemClient) DeleteDocument(ctx context.Context, in *DeleteDocumentRequest, opts ...grpc.CallOption) (*DeleteDocumentReply, error) {
+	out := new(DeleteDocumentReply)
+	err := c.cc.Invoke(ctx, "/wordsearchsystemgrpc.WordSearchSystem/DeleteDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordSearchSystemClient) SearchDocuments(ctx context.Context, in *SearchDocumentsRequest, opts ...grpc.CallOption) (*SearchDocumentsReply, error) {
+	out := new(SearchDocumentsReply)
+	err := c.cc.Invoke(ctx, "/wordsearchsystemgrpc.WordSearchSystem/SearchDocuments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordSearchSystemClient) TokenizeText(ctx context.Context, in *TokenizeTextRequest, opts ...grpc.CallOption) (*TokenizeTextReply, error) {
+	out := new(TokenizeTextReply)
+	err := c.cc.Invoke(ctx, "/wordsearchsystemgrpc.WordSearchSystem/TokenizeText", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordSearchSystemClient) AddWordsFromText(ctx context.Context, in *AddWordsFromTextRequest, opts ...grpc.CallOption) (*AddWordsFromTextReply, error) {
+	out := new(AddWordsFromTextReply)
+	err := c.cc.Invoke(ctx, "/wordsearchsystemgrpc.WordSearchSystem/AddWordsFromText", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordSearchSystemClient) CheckText(ctx context.Context, in *CheckTextRequest, opts ...grpc.CallOption) (*CheckTextReply, error) {
+	out := new(CheckTextReply)
+	err := c.cc.Invoke(ctx, "/wordsearchsystemgrpc.WordSearchSystem/CheckText", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordSearchSystemClient) Autocomplete(ctx context.Context, in *AutocompleteRequest, opts ...grpc.CallOption) (*AutocompleteReply, error) {
+	out := new(AutocompleteReply)
+	err := c.cc.Invoke(ctx, "/wordsearchsystemgrpc.WordSearchSystem/Autocomplete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordSearchSystemClient) PersonalizedSuggestions(ctx context.Context, in *PersonalizedSuggestionsRequest, opts ...grpc.CallOption) (*PersonalizedSuggestionsReply, error) {
+	out := new(PersonalizedSuggestionsReply)
+	err := c.cc.Invoke(ctx, "/wordsearchsystemgrpc.WordSearchSystem/PersonalizedSuggestions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordSearchSystemClient) EraseUserHistory(ctx context.Context, in *EraseUserHistoryRequest, opts ...grpc.CallOption) (*EraseUserHistoryReply, error) {
+	out := new(EraseUserHistoryReply)
+	err := c.cc.Invoke(ctx, "/wordsearchsystemgrpc.WordSearchSystem/EraseUserHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordSearchSystemClient) ListQueryRefinements(ctx context.Context, in *ListQueryRefinementsRequest, opts ...grpc.CallOption) (*ListQueryRefinementsReply, error) {
+	out := new(ListQueryRefinementsReply)
+	err := c.cc.Invoke(ctx, "/wordsearchsystemgrpc.WordSearchSystem/ListQueryRefinements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WordSearchSystemServer is the server API for WordSearchSystem service.
+type WordSearchSystemServer interface {
+	// Sends a greeting
+	SearchWord(context.Context, *SearchWordRequest) (*SearchWordReply, error)
+	AddWords(context.Context, *AddWordsRequest) (*AddWordsReply, error)
+	Top5SearchKeyWords(context.Context, *Top5SearchKeyWordsRequest) (*Top5SearchKeyWordsReply, error)
+	// Streams the whole dictionary in alphabetical order, in chunks
+	ExportWords(*ExportWordsRequest, WordSearchSystem_ExportWordsServer) error
+	// Adds the words of a stream in batches, counting rather than refusing duplicate and invalid words
+	AddWordsStream(WordSearchSystem_AddWordsStreamServer) error
+	CreateDictionary(context.Context, *CreateDictionaryRequest) (*CreateDictionaryReply, error)
+	ListDictionaries(context.Context, *ListDictionariesRequest) (*ListDictionariesReply, error)
+	// Deletes a dictionary along with its words and keyword statistics. The default dictionary cannot be deleted
+	DeleteDictionary(context.Context, *DeleteDictionaryRequest) (*DeleteDictionaryReply, error)
+	// Lists the versions kept for a dictionary, oldest first
+	ListVersions(context.Context, *ListVersionsRequest) (*ListVersionsReply, error)
+	// Atomically restores the words of an earlier version. The rollback produces a new version
+	RollbackDictionary(context.Context, *RollbackDictionaryRequest) (*RollbackDictionaryReply, error)
+	// Adds each word with its metadata, or replaces the metadata of words which already exist
+	UpsertWords(context.Context, *UpsertWordsRequest) (*UpsertWordsReply, error)
+	// A dry run of AddWords, which reports the words which would be refused without adding any
+	ValidateWords(context.Context, *ValidateWordsRequest) (*ValidateWordsReply, error)
+	// Blocked terms cannot be added to any of the tenant's dictionaries, and are hidden from search results and keyword rankings
+	AddBlockedTerms(context.Context, *AddBlockedTermsRequest) (*AddBlockedTermsReply, error)
+	RemoveBlockedTerms(context.Context, *RemoveBlockedTermsRequest) (*RemoveBlockedTermsReply, error)
+	ListBlockedTerms(context.Context, *ListBlockedTermsRequest) (*ListBlockedTermsReply, error)
+	// Lists the mutations made to the tenant's dictionaries, oldest first
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsReply, error)
+	// Streams the matches for a keyword in alphabetical batches as the dictionary is scanned
+	SearchWordStream(*SearchWordStreamRequest, WordSearchSystem_SearchWordStreamServer) error
+	// Returns the words matching a query such as "pre* AND NOT *fix" or "(cat OR dog) len:3-5"
+	QueryWords(context.Context, *QueryWordsRequest) (*QueryWordsReply, error)
+	// Synonym groups are shared by all of the tenant's dictionaries, and expand searches which ask for it
+	AddSynonymGroup(context.Context, *AddSynonymGroupRequest) (*AddSynonymGroupReply, error)
+	RemoveSynonymGroup(context.Context, *RemoveSynonymGroupRequest) (*RemoveSynonymGroupReply, error)
+	ListSynonymGroups(context.Context, *ListSynonymGroupsRequest) (*ListSynonymGroupsReply, error)
+	// Documents are indexed for full-text search, ranked by BM25. Indexing a document with an id already in use replaces it
+	IndexDocument(context.Context, *IndexDocumentRequest) (*IndexDocumentReply, error)
+	DeleteDocument(context.Context, *DeleteDocumentRequest) (*DeleteDocumentReply, error)
+	SearchDocuments(context.Context, *SearchDocumentsRequest) (*SearchDocumentsReply, error)
+	// Returns the distinct words of a text in the order they first appear, normalized the same way as AddWords normalizes words
+	TokenizeText(context.Context, *TokenizeTextRequest) (*TokenizeTextReply, error)
+	// Adds the distinct words of a text. Unlike AddWords, words which already exist or are refused are counted in the reply rather than failing the request
+	AddWordsFromText(context.Context, *AddWordsFromTextRequest) (*AddWordsFromTextReply, error)
+	// Returns every word of a text which is not in the dictionary, in the order they appear, with ranked corrections
+	CheckText(context.Context, *CheckTextRequest) (*CheckTextReply, error)
+	// Returns the dictionary words starting with a prefix, the most searched for first. Autocompleting does not count as searching for the prefix
+	Autocomplete(context.Context, *AutocompleteRequest) (*AutocompleteReply, error)
+	// Suggests keywords starting with a prefix for the user named by the user-id metadata, blending the user's own searches with every user's searches
+	PersonalizedSuggestions(context.Context, *PersonalizedSuggestionsRequest) (*PersonalizedSuggestionsReply, error)
+	// Forgets a user's searches in all of the tenant's dictionaries, and removes them from the query log.
+	// Requires one of the tenant's admin API keys, as the user-id metadata is set by the client and so cannot show the caller is the user
+	EraseUserHistory(context.Context, *EraseUserHistoryRequest) (*EraseUserHistoryReply, error)
+	// Lists the keywords searched for within a minute of a different keyword in the same search session, named by the session-id metadata, the most frequent first
+	ListQueryRefinements(context.Context, *ListQueryRefinementsRequest) (*ListQueryRefinementsReply, error)
+}
+
+func RegisterWordSearchSystemServer(s *grpc.Server, srv WordSearchSystemServer) {
+	s.RegisterService(&_WordSearchSystem_serviceDesc, srv)
+}
+
+func _WordSearchSystem_SearchWord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchWordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordSearchSystemServer).SearchWord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearchsystemgrpc.WordSearchSystem/SearchWord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordSearchSystemServer).SearchWord(ctx, req.(*SearchWordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordSearchSystem_AddWords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordSearchSystemServer).AddWords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearchsystemgrpc.WordSearchSystem/AddWords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordSearchSystemServer).AddWords(ctx, req.(*AddWordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordSearchSystem_Top5SearchKeyWords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Top5SearchKeyWordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordSearchSystemServer).Top5SearchKeyWords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearchsystemgrpc.WordSearchSystem/Top5SearchKeyWords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordSearchSystemServer).Top5SearchKeyWords(ctx, req.(*Top5SearchKeyWordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordSearchSystem_ExportWords_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportWordsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WordSearchSystemServer).ExportWords(m, &wordSearchSystemExportWordsServer{stream})
+}
+
+type WordSearchSystem_ExportWordsServer interface {
+	Send(*ExportWordsReply) error
+	grpc.ServerStream
+}
+
+type wordSearchSystemExportWordsServer struct {
+	grpc.ServerStream
+}
+
+func (x *wordSearchSystemExportWordsServer) Send(m *ExportWordsReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WordSearchSystem_AddWordsStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(WordSearchSystemServer).AddWordsStream(&wordSearchSystemAddWordsStreamServer{stream})
+}
+
+type WordSearchSystem_AddWordsStreamServer interface {
+	SendAndClose(*AddWordsStreamReply) error
+	Recv() (*AddWordsStreamRequest, error)
+	grpc.ServerStream
+}
+
+type wordSearchSystemAddWordsStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *wordSearchSystemAddWordsStreamServer) SendAndClose(m *AddWordsStreamReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *wordSearchSystemAddWordsStreamServer) Recv() (*AddWordsStreamRequest, error) {
+	m := new(AddWordsStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _WordSearchSystem_CreateDictionary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDictionaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordSearchSystemServer).CreateDictionary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearchsystemgrpc.WordSearchSystem/CreateDictionary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordSearchSystemServer).CreateDictionary(ctx, req.(*CreateDictionaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordSearchSystem_ListDictionaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDictionariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordSearchSystemServer).ListDictionaries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearchsystemgrpc.WordSearchSystem/ListDictionaries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordSearchSystemServer).ListDictionaries(ctx, req.(*ListDictionariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordSearchSystem_DeleteDictionary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDictionaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordSearchSystemServer).DeleteDictionary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearchsystemgrpc.WordSearchSystem/DeleteDictionary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordSearchSystemServer).DeleteDictionary(ctx, req.(*DeleteDictionaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordSearchSystem_ListVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordSearchSystemServer).ListVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearchsystemgrpc.WordSearchSystem/ListVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordSearchSystemServer).ListVersions(ctx, req.(*ListVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordSearchSystem_RollbackDictionary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackDictionaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordSearchSystemServer).RollbackDictionary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearchsystemgrpc.WordSearchSystem/RollbackDictionary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordSearchSystemServer).RollbackDictionary(ctx, req.(*RollbackDictionaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordSearchSystem_UpsertWords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertWordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordSearchSystemServer).UpsertWords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearchsystemgrpc.WordSearchSystem/UpsertWords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordSearchSystemServer).UpsertWords(ctx, req.(*UpsertWordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordSearchSystem_ValidateWords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateWordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordSearchSystemServer).ValidateWords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearchsystemgrpc.WordSearchSystem/ValidateWords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordSearchSystemServer).ValidateWords(ctx, req.(*ValidateWordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordSearchSystem_AddBlockedTerms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBlockedTermsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordSearchSystemServer).AddBlockedTerms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearchsystemgrpc.WordSearchSystem/AddBlockedTerms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordSearchSystemServer).AddBlockedTerms(ctx, req.(*AddBlockedTermsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordSearchSystem_RemoveBlockedTerms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveBlockedTermsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordSearchSystemServer).RemoveBlockedTerms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearchsystemgrpc.WordSearchSystem/RemoveBlockedTerms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordSearchSystemServer).RemoveBlockedTerms(ctx, req.(*RemoveBlockedTermsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordSearchSystem_ListBlockedTerms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlockedTermsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordSearchSystemServer).ListBlockedTerms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearchsystemgrpc.WordSearchSystem/ListBlockedTerms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordSearchSystemServer).ListBlockedTerms(ctx, req.(*ListBlockedTermsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordSearchSystem_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordSearchSystemServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearchsystemgrpc.WordSearchSystem/ListAuditEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordSearchSystemServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordSearchSystem_SearchWordStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SearchWordStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WordSearchSystemServer).SearchWordStream(m, &wordSearchSystemSearchWordStreamServer{stream})
+}
+
+type WordSearchSystem_SearchWordStreamServer interface {
+	Send(*SearchWordStreamReply) error
+	grpc.ServerStream
+}
+
+type wordSearchSystemSearchWordStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *wordSearchSystemSearchWordStreamServer) Send(m *SearchWordStreamReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WordSearchSystem_QueryWords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordSearchSystemServer).QueryWords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearchsystemgrpc.WordSearchSystem/QueryWords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordSearchSystemServer).QueryWords(ctx, req.(*QueryWordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordSearchSystem_AddSynonymGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSynonymGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordSearchSystemServer).AddSynonymGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearchsystemgrpc.WordSearchSystem/AddSynonymGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordSearchSystemServer).AddSynonymGroup(ctx, req.(*AddSynonymGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordSearchSystem_RemoveSynonymGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSynonymGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordSearchSystemServer).RemoveSynonymGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearchsystemgrpc.WordSearchSystem/RemoveSynonymGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordSearchSystemServer).RemoveSynonymGroup(ctx, req.(*RemoveSynonymGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordSearchSystem_ListSynonymGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSynonymGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordSearchSystemServer).ListSynonymGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearchsystemgrpc.WordSearchSystem/ListSynonymGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordSearchSystemServer).ListSynonymGroups(ctx, req.(*ListSynonymGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordSearchSystem_IndexDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IndexDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordSearchSystemServer).IndexDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearchsystemgrpc.WordSearchSystem/IndexDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordSearchSystemServer).IndexDocument(ctx, req.(*IndexDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordSearchSystem_DeleteDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordSearchSystemServer).DeleteDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearchsystemgrpc.WordSearchSystem/DeleteDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordSearchSystemServer).DeleteDocument(ctx, req.(*DeleteDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordSearchSystem_SearchDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchDocumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordSearchSystemServer).SearchDocuments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearchsystemgrpc.WordSearchSystem/SearchDocuments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordSearchSystemServer).SearchDocuments(ctx, req.(*SearchDocumentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordSearchSystem_TokenizeText_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenizeTextRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordSearchSystemServer).TokenizeText(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearchsystemgrpc.WordSearchSystem/TokenizeText",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordSearchSystemServer).TokenizeText(ctx, req.(*TokenizeTextRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordSearchSystem_AddWordsFromText_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWordsFromTextRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordSearchSystemServer).AddWordsFromText(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearchsystemgrpc.WordSearchSystem/AddWordsFromText",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordSearchSystemServer).AddWordsFromText(ctx, req.(*AddWordsFromTextRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordSearchSystem_CheckText_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckTextRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordSearchSystemServer).CheckText(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearchsystemgrpc.WordSearchSystem/CheckText",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordSearchSystemServer).CheckText(ctx, req.(*CheckTextRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordSearchSystem_Autocomplete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutocompleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordSearchSystemServer).Autocomplete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearchsystemgrpc.WordSearchSystem/Autocomplete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordSearchSystemServer).Autocomplete(ctx, req.(*AutocompleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordSearchSystem_PersonalizedSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PersonalizedSuggestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordSearchSystemServer).PersonalizedSuggestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearchsystemgrpc.WordSearchSystem/PersonalizedSuggestions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordSearchSystemServer).PersonalizedSuggestions(ctx, req.(*PersonalizedSuggestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordSearchSystem_EraseUserHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EraseUserHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordSearchSystemServer).EraseUserHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearchsystemgrpc.WordSearchSystem/EraseUserHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordSearchSystemServer).EraseUserHistory(ctx, req.(*EraseUserHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordSearchSystem_ListQueryRefinements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueryRefinementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordSearchSystemServer).ListQueryRefinements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wordsearchsystemgrpc.WordSearchSystem/ListQueryRefinements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordSearchSystemServer).ListQueryRefinements(ctx, req.(*ListQueryRefinementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _WordSearchSystem_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "wordsearchsystemgrpc.WordSearchSystem",
+	HandlerType: (*WordSearchSystemServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SearchWord",
+			Handler:    _WordSearchSystem_SearchWord_Handler,
+		},
+		{
+			MethodName: "AddWords",
+			Handler:    _WordSearchSystem_AddWords_Handler,
+		},
+		{
+			MethodName: "Top5SearchKeyWords",
+			Handler:    _WordSearchSystem_Top5SearchKeyWords_Handler,
+		},
+		{
+			MethodName: "CreateDictionary",
+			Handler:    _WordSearchSystem_CreateDictionary_Handler,
+		},
+		{
+			MethodName: "ListDictionaries",
+			Handler:    _WordSearchSystem_ListDictionaries_Handler,
+		},
+		{
+			MethodName: "DeleteDictionary",
+			Handler:    _WordSearchSystem_DeleteDictionary_Handler,
+		},
+		{
+			MethodName: "ListVersions",
+			Handler:    _WordSearchSystem_ListVersions_Handler,
+		},
+		{
+			MethodName: "RollbackDictionary",
+			Handler:    _WordSearchSystem_RollbackDictionary_Handler,
+		},
+		{
+			MethodName: "UpsertWords",
+			Handler:    _WordSearchSystem_UpsertWords_Handler,
+		},
+		{
+			MethodName: "ValidateWords",
+			Handler:    _WordSearchSystem_ValidateWords_Handler,
+		},
+		{
+			MethodName: "AddBlockedTerms",
+			Handler:    _WordSearchSystem_AddBlockedTerms_Handler,
+		},
+		{
+			MethodName: "RemoveBlockedTerms",
+			Handler:    _WordSearchSystem_RemoveBlockedTerms_Handler,
+		},
+		{
+			MethodName: "ListBlockedTerms",
+			Handler:    _WordSearchSystem_ListBlockedTerms_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _WordSearchSystem_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "QueryWords",
+			Handler:    _WordSearchSystem_QueryWords_Handler,
+		},
+		{
+			MethodName: "AddSynonymGroup",
+			Handler:    _WordSearchSystem_AddSynonymGroup_Handler,
+		},
+		{
+			MethodName: "RemoveSynonymGroup",
+			Handler:    _WordSearchSystem_RemoveSynonymGroup_Handler,
+		},
+		{
+			MethodName: "ListSynonymGroups",
+			Handler:    _WordSearchSystem_ListSynonymGroups_Handler,
+		},
+		{
+			MethodName: "IndexDocument",
+			Handler:    _WordSearchSystem_IndexDocument_Handler,
+		},
+		{
+			MethodName: "DeleteDocument",
+			Handler:    _WordSearchSystem_DeleteDocument_Handler,
+		},
+		{
+			MethodName: "SearchDocuments",
+			Handler:    _WordSearchSystem_SearchDocuments_Handler,
+		},
+		{
+			MethodName: "TokenizeText",
+			Handler:    _WordSearchSystem_TokenizeText_Handler,
+		},
+		{
+			MethodName: "AddWordsFromText",
+			Handler:    _WordSearchSystem_AddWordsFromText_Handler,
+		},
+		{
+			MethodName: "CheckText",
+			Handler:    _WordSearchSystem_CheckText_Handler,
+		},
+		{
+			MethodName: "Autocomplete",
+			Handler:    _WordSearchSystem_Autocomplete_Handler,
+		},
+		{
+			MethodName: "PersonalizedSuggestions",
+			Handler:    _WordSearchSystem_PersonalizedSuggestions_Handler,
+		},
+		{
+			MethodName: "EraseUserHistory",
+			Handler:    _WordSearchSystem_EraseUserHistory_Handler,
+		},
+		{
+			MethodName: "ListQueryRefinements",
+			Handler:    _WordSearchSystem_ListQueryRefinements_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportWords",
+			Handler:       _WordSearchSystem_ExportWords_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "AddWordsStream",
+			Handler:       _WordSearchSystem_AddWordsStream_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "SearchWordStream",
+			Handler:       _WordSearchSystem_SearchWordStream_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "word_search_system_grpc.proto",
+}
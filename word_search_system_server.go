@@ -2,40 +2,893 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+	"time"
 
-	wordsearchsystemgrpc "github.com/chrisjpalmer/word_search_system_grpc"
+	wordsearchsystemgrpc "github.com/chrisjpalmer/word_search_system/word_search_system_grpc"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 )
 
+//tenantMetadataKey - the gRPC metadata key which names the tenant a request is made for. When absent the tenant is the one the API key belongs to,
+// or the default tenant for requests without an API key
+const tenantMetadataKey = "tenant-id"
+
+//authorizationMetadataKey - the gRPC metadata key which carries the client's API key as "Bearer <key>"
+const authorizationMetadataKey = "authorization"
+
+//userMetadataKey - the gRPC metadata key which identifies the user making a request. The client sets it and it is not authenticated,
+// so it personalises searches and is recorded as the caller in the audit and query logs, but it never grants access to a user's data
+const userMetadataKey = "user-id"
+
+//sessionMetadataKey - the gRPC metadata key which identifies the search session a request belongs to
+const sessionMetadataKey = "session-id"
+
+//requestIDMetadataKey - the gRPC metadata key which carries the client's id for a request. An id is generated when absent
+const requestIDMetadataKey = "x-request-id"
+
+//maxSearchPageSize - the largest page of matches SearchWord returns when the client asks for a page size.
+// Clients which do not ask for one, as before pagination, are given every match
+const maxSearchPageSize = 1000
+
+//matchModes - the MatchMode for each match mode of a request. Unknown match modes match substrings
+var matchModes = map[wordsearchsystemgrpc.MatchMode]MatchMode{
+	wordsearchsystemgrpc.MatchMode_MATCH_SUBSTRING:     MatchSubstring,
+	wordsearchsystemgrpc.MatchMode_MATCH_PHRASE_PREFIX: MatchPhrasePrefix,
+	wordsearchsystemgrpc.MatchMode_MATCH_TOKEN_PREFIX:  MatchTokenPrefix,
+}
+
 //WordSearchSystemServer - an struct which implements the wordsearchsystemgrpc.WordSearchSystemServer interface to handle gRPC requests.
-// It handles the requests and executes logic on the wordSearchService object which is the brain of the application
+// It handles the requests and executes logic on the requesting tenant's wordSearchService object which is the brain of the application
+// Every mutation is recorded in the auditLog, and searches in the queryLog if there is one
 type WordSearchSystemServer struct {
-	wordSearchService *WordSearchService
+	tenantRegistry *TenantRegistry
+	auditLog       *AuditLog
+	queryLog       *QueryLog
 }
 
-//NewWordSearchSystemServer - initializes a new wordSearchSystemServer
-func NewWordSearchSystemServer(wordSearchService *WordSearchService) *WordSearchSystemServer {
+//NewWordSearchSystemServer - initializes a new wordSearchSystemServer. queryLog may be nil when searches are not logged
+func NewWordSearchSystemServer(tenantRegistry *TenantRegistry, auditLog *AuditLog, queryLog *QueryLog) *WordSearchSystemServer {
 	_newWordSearchSystemServer := new(WordSearchSystemServer)
-	_newWordSearchSystemServer.wordSearchService = wordSearchService
+	_newWordSearchSystemServer.tenantRegistry = tenantRegistry
+	_newWordSearchSystemServer.auditLog = auditLog
+	_newWordSearchSystemServer.queryLog = queryLog
 	return _newWordSearchSystemServer
 }
 
 //SearchWord - handles SearchWord request to search for words in the words list
 func (wordSearchSystemServer *WordSearchSystemServer) SearchWord(ctx context.Context, in *wordsearchsystemgrpc.SearchWordRequest) (*wordsearchsystemgrpc.SearchWordReply, error) {
-	matches := wordSearchSystemServer.wordSearchService.SearchWord(in.KeyWord)
-	return &wordsearchsystemgrpc.SearchWordReply{Matches: matches}, nil
+	tenant, dictionary, err := wordSearchSystemServer.dictionary(ctx, in.Dictionary)
+	if err != nil {
+		return nil, err
+	}
+	start := time.Now()
+	reply, err := searchWord(ctx, dictionary, in)
+	if errors.Cause(err) == ErrVersionNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Cause(err) == ErrInvalidPageToken {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	wordSearchSystemServer.logQuery(ctx, tenant, dictionary, in, int(reply.TotalMatches), time.Since(start))
+	return reply, nil
+}
+
+//searchWord - searches dictionary in the way the request and its metadata ask for
+func searchWord(ctx context.Context, dictionary *WordDictionary, in *wordsearchsystemgrpc.SearchWordRequest) (*wordsearchsystemgrpc.SearchWordReply, error) {
+	pageSize := int(in.PageSize)
+	if pageSize < 0 {
+		pageSize = 0
+	}
+	if pageSize > maxSearchPageSize {
+		pageSize = maxSearchPageSize
+	}
+	options := SearchOptions{
+		PageSize:          pageSize,
+		PageToken:         in.PageToken,
+		Version:           in.Version,
+		IncludeMetadata:   in.IncludeMetadata,
+		PartOfSpeech:      in.PartOfSpeech,
+		Tags:              in.Tags,
+		RankByRelevance:   in.RankByRelevance,
+		IncludeHighlights: in.IncludeHighlights,
+		Stemming:          in.Stemming,
+		ExpandSynonyms:    in.ExpandSynonyms,
+		MatchMode:         matchModes[in.MatchMode],
+		UserID:            metadataValue(ctx, userMetadataKey),
+		SessionID:         metadataValue(ctx, sessionMetadataKey),
+	}
+	results, err := dictionary.Search(in.KeyWord, options)
+	if err != nil {
+		return nil, err
+	}
+	reply := &wordsearchsystemgrpc.SearchWordReply{
+		Matches:       make([]string, len(results.Matches)),
+		Version:       results.Version,
+		Results:       make([]*wordsearchsystemgrpc.SearchMatch, len(results.Matches)),
+		NextPageToken: results.NextPageToken,
+		TotalMatches:  int32(results.TotalMatches),
+	}
+	for i, match := range results.Matches {
+		reply.Matches[i] = match.Word
+		reply.Results[i] = searchMatchReply(match)
+	}
+	return reply, nil
+}
+
+//searchMatchReply - converts a match to its reply message
+func searchMatchReply(match SearchMatch) *wordsearchsystemgrpc.SearchMatch {
+	reply := &wordsearchsystemgrpc.SearchMatch{Word: match.Word, Score: match.Score, ExpandedFrom: match.ExpandedFrom}
+	for _, span := range match.Highlights {
+		reply.Highlights = append(reply.Highlights, &wordsearchsystemgrpc.HighlightSpan{Start: int32(span.Start), End: int32(span.End)})
+	}
+	if match.Metadata != nil {
+		reply.Metadata = &wordsearchsystemgrpc.WordMetadata{
+			Definition:   match.Metadata.Definition,
+			PartOfSpeech: match.Metadata.PartOfSpeech,
+			Tags:         match.Metadata.Tags,
+			Attributes:   match.Metadata.Attributes,
+		}
+	}
+	return reply
+}
+
+//SearchWordStream - handles the SearchWordStream request to stream the matches for a keyword in batches as the dictionary is scanned.
+// The scan stops as soon as the client hangs up
+func (wordSearchSystemServer *WordSearchSystemServer) SearchWordStream(in *wordsearchsystemgrpc.SearchWordStreamRequest, stream wordsearchsystemgrpc.WordSearchSystem_SearchWordStreamServer) error {
+	ctx := stream.Context()
+	_, dictionary, err := wordSearchSystemServer.dictionary(ctx, in.Dictionary)
+	if err != nil {
+		return err
+	}
+	options := SearchOptions{
+		IncludeMetadata:   in.IncludeMetadata,
+		PartOfSpeech:      in.PartOfSpeech,
+		Tags:              in.Tags,
+		IncludeHighlights: in.IncludeHighlights,
+		Stemming:          in.Stemming,
+		ExpandSynonyms:    in.ExpandSynonyms,
+		MatchMode:         matchModes[in.MatchMode],
+		UserID:            metadataValue(ctx, userMetadataKey),
+		SessionID:         metadataValue(ctx, sessionMetadataKey),
+	}
+	return dictionary.StreamSearch(ctx, in.KeyWord, options, int(in.BatchSize), func(matches []SearchMatch) error {
+		reply := &wordsearchsystemgrpc.SearchWordStreamReply{Matches: make([]string, len(matches)), Results: make([]*wordsearchsystemgrpc.SearchMatch, len(matches))}
+		for i, match := range matches {
+			reply.Matches[i] = match.Word
+			reply.Results[i] = searchMatchReply(match)
+		}
+		return stream.Send(reply)
+	})
+}
+
+//Autocomplete - handles the Autocomplete request to complete a prefix with the most searched for dictionary words
+func (wordSearchSystemServer *WordSearchSystemServer) Autocomplete(ctx context.Context, in *wordsearchsystemgrpc.AutocompleteRequest) (*wordsearchsystemgrpc.AutocompleteReply, error) {
+	_, dictionary, err := wordSearchSystemServer.dictionary(ctx, in.Dictionary)
+	if err != nil {
+		return nil, err
+	}
+	completions := dictionary.Autocomplete(in.Prefix, int(in.Limit))
+	reply := &wordsearchsystemgrpc.AutocompleteReply{Completions: make([]*wordsearchsystemgrpc.Completion, len(completions))}
+	for i, completion := range completions {
+		reply.Completions[i] = &wordsearchsystemgrpc.Completion{Word: completion.Word, SearchCount: completion.SearchCount, Score: completion.Score}
+	}
+	return reply, nil
+}
+
+//PersonalizedSuggestions - handles the PersonalizedSuggestions request to suggest keywords for the user making the request
+func (wordSearchSystemServer *WordSearchSystemServer) PersonalizedSuggestions(ctx context.Context, in *wordsearchsystemgrpc.PersonalizedSuggestionsRequest) (*wordsearchsystemgrpc.PersonalizedSuggestionsReply, error) {
+	_, dictionary, err := wordSearchSystemServer.dictionary(ctx, in.Dictionary)
+	if err != nil {
+		return nil, err
+	}
+	suggestions := dictionary.PersonalizedSuggestions(metadataValue(ctx, userMetadataKey), in.Prefix, int(in.Limit))
+	return &wordsearchsystemgrpc.PersonalizedSuggestionsReply{Suggestions: suggestions}, nil
+}
+
+//EraseUserHistory - handles the EraseUserHistory request to forget a user's searches, both in the tenant's dictionaries and in the query log.
+// Only the tenant's administrators may erase a history: the user-id metadata cannot show the caller is the user, as any client can set it
+func (wordSearchSystemServer *WordSearchSystemServer) EraseUserHistory(ctx context.Context, in *wordsearchsystemgrpc.EraseUserHistoryRequest) (*wordsearchsystemgrpc.EraseUserHistoryReply, error) {
+	tenant, err := wordSearchSystemServer.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !tenant.IsAdminAPIKey(requestAPIKey(ctx)) {
+		return nil, status.Error(codes.PermissionDenied, "erasing a user's history requires an admin API key of tenant "+tenant.ID())
+	}
+	if in.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "a user id is required")
+	}
+	tenant.WordSearchService().EraseUserHistory(in.UserId)
+	if err = wordSearchSystemServer.queryLog.EraseCaller(tenant.ID(), in.UserId); err != nil {
+		return nil, err
+	}
+	return &wordsearchsystemgrpc.EraseUserHistoryReply{}, nil
+}
+
+//ListQueryRefinements - handles the ListQueryRefinements request to list how users refined their searches within their search sessions
+func (wordSearchSystemServer *WordSearchSystemServer) ListQueryRefinements(ctx context.Context, in *wordsearchsystemgrpc.ListQueryRefinementsRequest) (*wordsearchsystemgrpc.ListQueryRefinementsReply, error) {
+	_, dictionary, err := wordSearchSystemServer.dictionary(ctx, in.Dictionary)
+	if err != nil {
+		return nil, err
+	}
+	refinements := dictionary.QueryRefinements(QueryRefinementsOptions{MinCount: in.MinCount, MaxEditDistance: int(in.MaxEditDistance), Limit: int(in.Limit)})
+	reply := &wordsearchsystemgrpc.ListQueryRefinementsReply{Refinements: make([]*wordsearchsystemgrpc.QueryRefinement, len(refinements))}
+	for i, refinement := range refinements {
+		reply.Refinements[i] = &wordsearchsystemgrpc.QueryRefinement{From: refinement.From, To: refinement.To, Count: refinement.Count, EditDistance: int32(refinement.EditDistance)}
+	}
+	return reply, nil
+}
+
+//QueryWords - handles the QueryWords request to find the words matching a query. A query which cannot be parsed is refused with the column of the problem
+func (wordSearchSystemServer *WordSearchSystemServer) QueryWords(ctx context.Context, in *wordsearchsystemgrpc.QueryWordsRequest) (*wordsearchsystemgrpc.QueryWordsReply, error) {
+	_, dictionary, err := wordSearchSystemServer.dictionary(ctx, in.Dictionary)
+	if err != nil {
+		return nil, err
+	}
+	matches, err := dictionary.QueryWords(in.Query)
+	if syntaxErr, ok := err.(*QuerySyntaxError); ok {
+		return nil, querySyntaxStatus(syntaxErr)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &wordsearchsystemgrpc.QueryWordsReply{Matches: matches}, nil
 }
 
 //AddWords - handles the AddWords request to add words to the words list
 func (wordSearchSystemServer *WordSearchSystemServer) AddWords(ctx context.Context, in *wordsearchsystemgrpc.AddWordsRequest) (*wordsearchsystemgrpc.AddWordsReply, error) {
-	err := wordSearchSystemServer.wordSearchService.AddWords(in.Words)
+	tenant, dictionary, err := wordSearchSystemServer.dictionary(ctx, in.Dictionary)
+	if err != nil {
+		return nil, err
+	}
+	options, err := addWordsOptions(in.ExpiresAt, in.Ttl)
 	if err != nil {
 		return nil, err
 	}
-	return &wordsearchsystemgrpc.AddWordsReply{}, nil
+	version, err := dictionary.AddWordsWithOptions(in.Words, options)
+	wordSearchSystemServer.audit(ctx, tenant, dictionary, "AddWords", version, err)
+	if err != nil {
+		return nil, addWordsStatus(err)
+	}
+	return &wordsearchsystemgrpc.AddWordsReply{Version: version}, nil
+}
+
+//UpsertWords - handles the UpsertWords request to add words with their metadata, or replace the metadata of existing words
+func (wordSearchSystemServer *WordSearchSystemServer) UpsertWords(ctx context.Context, in *wordsearchsystemgrpc.UpsertWordsRequest) (*wordsearchsystemgrpc.UpsertWordsReply, error) {
+	tenant, dictionary, err := wordSearchSystemServer.dictionary(ctx, in.Dictionary)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]WordEntry, len(in.Words))
+	for i, word := range in.Words {
+		options, err := addWordsOptions(word.ExpiresAt, word.Ttl)
+		if err != nil {
+			return nil, err
+		}
+		entries[i].Word = word.Word
+		entries[i].ExpiresAt = options.ExpiresAt
+		entries[i].TTL = options.TTL
+		if word.Metadata != nil {
+			entries[i].Metadata = WordMetadata{
+				Definition:   word.Metadata.Definition,
+				PartOfSpeech: word.Metadata.PartOfSpeech,
+				Tags:         word.Metadata.Tags,
+				Attributes:   word.Metadata.Attributes,
+			}
+		}
+	}
+	version, err := dictionary.UpsertWords(entries)
+	wordSearchSystemServer.audit(ctx, tenant, dictionary, "UpsertWords", version, err)
+	if err != nil {
+		return nil, addWordsStatus(err)
+	}
+	return &wordsearchsystemgrpc.UpsertWordsReply{Version: version}, nil
+}
+
+//AddWordsStream - handles the AddWordsStream request to add the words of a client stream in batches, replying with a summary once the client closes the stream.
+// Unlike AddWords, words which already exist or are refused are counted in the summary rather than failing the request. Each batch is audited
+func (wordSearchSystemServer *WordSearchSystemServer) AddWordsStream(stream wordsearchsystemgrpc.WordSearchSystem_AddWordsStreamServer) error {
+	in, err := stream.Recv()
+	if err == io.EOF {
+		return stream.SendAndClose(&wordsearchsystemgrpc.AddWordsStreamReply{})
+	}
+	if err != nil {
+		return err
+	}
+	tenant, dictionary, err := wordSearchSystemServer.dictionary(stream.Context(), in.Dictionary)
+	if err != nil {
+		return err
+	}
+	wordIngester := NewWordIngester(dictionary, 0)
+	defer func() {
+		for _, version := range wordIngester.versions {
+			wordSearchSystemServer.audit(stream.Context(), tenant, dictionary, "AddWordsStream", version, nil)
+		}
+	}()
+	for {
+		wordIngester.Add(in.Words)
+		in, err = stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	summary := wordIngester.Close()
+	return stream.SendAndClose(&wordsearchsystemgrpc.AddWordsStreamReply{
+		Added:      summary.Added,
+		Duplicates: summary.Duplicates,
+		Rejected:   summary.Rejected,
+	})
+}
+
+//TokenizeText - handles the TokenizeText request to find the distinct words of a text
+func (wordSearchSystemServer *WordSearchSystemServer) TokenizeText(ctx context.Context, in *wordsearchsystemgrpc.TokenizeTextRequest) (*wordsearchsystemgrpc.TokenizeTextReply, error) {
+	if _, err := wordSearchSystemServer.tenant(ctx); err != nil {
+		return nil, err
+	}
+	textWords := TokenizeText(in.Text, TokenizeOptions{KeepStopwords: in.KeepStopwords, Stopwords: stopwordSet(in.Stopwords), IncludeFrequencies: in.IncludeFrequencies})
+	reply := &wordsearchsystemgrpc.TokenizeTextReply{Words: make([]*wordsearchsystemgrpc.TextWord, len(textWords))}
+	for i, textWord := range textWords {
+		reply.Words[i] = &wordsearchsystemgrpc.TextWord{Word: textWord.Word, Frequency: int64(textWord.Frequency)}
+	}
+	return reply, nil
+}
+
+//AddWordsFromText - handles the AddWordsFromText request to add the distinct words of a text, replying with a summary of what was added
+func (wordSearchSystemServer *WordSearchSystemServer) AddWordsFromText(ctx context.Context, in *wordsearchsystemgrpc.AddWordsFromTextRequest) (*wordsearchsystemgrpc.AddWordsFromTextReply, error) {
+	tenant, dictionary, err := wordSearchSystemServer.dictionary(ctx, in.Dictionary)
+	if err != nil {
+		return nil, err
+	}
+	summary, version := dictionary.AddWordsFromText(in.Text, TokenizeOptions{KeepStopwords: in.KeepStopwords, Stopwords: stopwordSet(in.Stopwords)})
+	if version != 0 {
+		wordSearchSystemServer.audit(ctx, tenant, dictionary, "AddWordsFromText", version, nil)
+	}
+	return &wordsearchsystemgrpc.AddWordsFromTextReply{
+		Added:      summary.Added,
+		Duplicates: summary.Duplicates,
+		Rejected:   summary.Rejected,
+		Version:    version,
+	}, nil
+}
+
+//CheckText - handles the CheckText request to spell-check a text against the dictionary
+func (wordSearchSystemServer *WordSearchSystemServer) CheckText(ctx context.Context, in *wordsearchsystemgrpc.CheckTextRequest) (*wordsearchsystemgrpc.CheckTextReply, error) {
+	_, dictionary, err := wordSearchSystemServer.dictionary(ctx, in.Dictionary)
+	if err != nil {
+		return nil, err
+	}
+	issues := dictionary.CheckText(in.Text, CheckTextOptions{MaxSuggestions: int(in.MaxSuggestions), MaxEditDistance: int(in.MaxEditDistance)})
+	reply := &wordsearchsystemgrpc.CheckTextReply{Issues: make([]*wordsearchsystemgrpc.SpellingIssue, len(issues))}
+	for i, issue := range issues {
+		reply.Issues[i] = &wordsearchsystemgrpc.SpellingIssue{
+			Word:        issue.Word,
+			ByteStart:   int32(issue.ByteStart),
+			ByteEnd:     int32(issue.ByteEnd),
+			RuneStart:   int32(issue.RuneStart),
+			RuneEnd:     int32(issue.RuneEnd),
+			Suggestions: make([]*wordsearchsystemgrpc.SpellingSuggestion, len(issue.Suggestions)),
+		}
+		for j, suggestion := range issue.Suggestions {
+			reply.Issues[i].Suggestions[j] = &wordsearchsystemgrpc.SpellingSuggestion{Word: suggestion.Word, Distance: int32(suggestion.Distance)}
+		}
+	}
+	return reply, nil
+}
+
+//stopwordSet - converts the stopwords of a request to the normalized set TokenizeOptions takes, or nil for the default stopwords when there are none
+func stopwordSet(stopwords []string) map[string]bool {
+	if len(stopwords) == 0 {
+		return nil
+	}
+	set := make(map[string]bool, len(stopwords))
+	for _, stopword := range stopwords {
+		set[normalizeWord(stopword)] = true
+	}
+	return set
+}
+
+//ValidateWords - handles the ValidateWords request to report the words AddWords would refuse, without adding any
+func (wordSearchSystemServer *WordSearchSystemServer) ValidateWords(ctx context.Context, in *wordsearchsystemgrpc.ValidateWordsRequest) (*wordsearchsystemgrpc.ValidateWordsReply, error) {
+	_, dictionary, err := wordSearchSystemServer.dictionary(ctx, in.Dictionary)
+	if err != nil {
+		return nil, err
+	}
+	violations, err := dictionary.ValidateWords(in.Words)
+	if err != nil && errors.Cause(err) != ErrWordLimitExceeded {
+		return nil, err
+	}
+	reply := &wordsearchsystemgrpc.ValidateWordsReply{
+		Violations:       make([]*wordsearchsystemgrpc.WordViolation, len(violations)),
+		ExceedsWordLimit: err != nil,
+	}
+	for i, violation := range violations {
+		reply.Violations[i] = &wordsearchsystemgrpc.WordViolation{Index: int32(violation.Index), Word: violation.Word, Reason: violation.Reason}
+	}
+	return reply, nil
 }
 
 //Top5SearchKeyWords - handles the Top5SearchKeyWords to get the top 5 keywords that were searched
 func (wordSearchSystemServer *WordSearchSystemServer) Top5SearchKeyWords(ctx context.Context, in *wordsearchsystemgrpc.Top5SearchKeyWordsRequest) (*wordsearchsystemgrpc.Top5SearchKeyWordsReply, error) {
-	keyWords := wordSearchSystemServer.wordSearchService.Top5SearchKeyWords()
+	_, dictionary, err := wordSearchSystemServer.dictionary(ctx, in.Dictionary)
+	if err != nil {
+		return nil, err
+	}
+	keyWords := dictionary.Top5SearchKeyWords()
 	return &wordsearchsystemgrpc.Top5SearchKeyWordsReply{Keywords: keyWords}, nil
 }
+
+//ExportWords - handles the ExportWords request to stream the whole dictionary in the requested format
+func (wordSearchSystemServer *WordSearchSystemServer) ExportWords(in *wordsearchsystemgrpc.ExportWordsRequest, stream wordsearchsystemgrpc.WordSearchSystem_ExportWordsServer) error {
+	_, dictionary, err := wordSearchSystemServer.dictionary(stream.Context(), in.Dictionary)
+	if err != nil {
+		return err
+	}
+	format, err := ParseExportFormat(in.Format)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return dictionary.ExportWords(format, int(in.ChunkSize), func(chunk []byte) error {
+		return stream.Send(&wordsearchsystemgrpc.ExportWordsReply{Chunk: chunk})
+	})
+}
+
+//CreateDictionary - handles the CreateDictionary request to create a new, empty dictionary
+func (wordSearchSystemServer *WordSearchSystemServer) CreateDictionary(ctx context.Context, in *wordsearchsystemgrpc.CreateDictionaryRequest) (*wordsearchsystemgrpc.CreateDictionaryReply, error) {
+	tenant, err := wordSearchSystemServer.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	dictionary, err := tenant.WordSearchService().CreateDictionary(in.Name)
+	if errors.Cause(err) == ErrDictionaryExists {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+	if errors.Cause(err) == ErrDictionaryLimitExceeded {
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	wordSearchSystemServer.audit(ctx, tenant, dictionary, "CreateDictionary", dictionary.Version(), nil)
+	return &wordsearchsystemgrpc.CreateDictionaryReply{}, nil
+}
+
+//ListDictionaries - handles the ListDictionaries request to list the names of the tenant's dictionaries
+func (wordSearchSystemServer *WordSearchSystemServer) ListDictionaries(ctx context.Context, in *wordsearchsystemgrpc.ListDictionariesRequest) (*wordsearchsystemgrpc.ListDictionariesReply, error) {
+	tenant, err := wordSearchSystemServer.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &wordsearchsystemgrpc.ListDictionariesReply{Names: tenant.WordSearchService().ListDictionaries()}, nil
+}
+
+//DeleteDictionary - handles the DeleteDictionary request to delete a dictionary along with its words and keyword statistics
+func (wordSearchSystemServer *WordSearchSystemServer) DeleteDictionary(ctx context.Context, in *wordsearchsystemgrpc.DeleteDictionaryRequest) (*wordsearchsystemgrpc.DeleteDictionaryReply, error) {
+	tenant, dictionary, err := wordSearchSystemServer.dictionary(ctx, in.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = tenant.WordSearchService().DeleteDictionary(in.Name)
+	wordSearchSystemServer.audit(ctx, tenant, dictionary, "DeleteDictionary", 0, err)
+	if errors.Cause(err) == ErrDictionaryNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return &wordsearchsystemgrpc.DeleteDictionaryReply{}, nil
+}
+
+//AddBlockedTerms - handles the AddBlockedTerms request to block terms in all of the tenant's dictionaries
+func (wordSearchSystemServer *WordSearchSystemServer) AddBlockedTerms(ctx context.Context, in *wordsearchsystemgrpc.AddBlockedTermsRequest) (*wordsearchsystemgrpc.AddBlockedTermsReply, error) {
+	tenant, err := wordSearchSystemServer.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tenant.WordSearchService().Blocklist().AddTerms(in.Terms)
+	return &wordsearchsystemgrpc.AddBlockedTermsReply{}, nil
+}
+
+//RemoveBlockedTerms - handles the RemoveBlockedTerms request to unblock terms
+func (wordSearchSystemServer *WordSearchSystemServer) RemoveBlockedTerms(ctx context.Context, in *wordsearchsystemgrpc.RemoveBlockedTermsRequest) (*wordsearchsystemgrpc.RemoveBlockedTermsReply, error) {
+	tenant, err := wordSearchSystemServer.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tenant.WordSearchService().Blocklist().RemoveTerms(in.Terms)
+	return &wordsearchsystemgrpc.RemoveBlockedTermsReply{}, nil
+}
+
+//ListBlockedTerms - handles the ListBlockedTerms request to list the tenant's blocked terms
+func (wordSearchSystemServer *WordSearchSystemServer) ListBlockedTerms(ctx context.Context, in *wordsearchsystemgrpc.ListBlockedTermsRequest) (*wordsearchsystemgrpc.ListBlockedTermsReply, error) {
+	tenant, err := wordSearchSystemServer.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &wordsearchsystemgrpc.ListBlockedTermsReply{Terms: tenant.WordSearchService().Blocklist().ListTerms()}, nil
+}
+
+//AddSynonymGroup - handles the AddSynonymGroup request to add a group of synonyms for the tenant
+func (wordSearchSystemServer *WordSearchSystemServer) AddSynonymGroup(ctx context.Context, in *wordsearchsystemgrpc.AddSynonymGroupRequest) (*wordsearchsystemgrpc.AddSynonymGroupReply, error) {
+	tenant, err := wordSearchSystemServer.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := tenant.WordSearchService().Synonyms().AddGroup(in.Words)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &wordsearchsystemgrpc.AddSynonymGroupReply{Id: id}, nil
+}
+
+//RemoveSynonymGroup - handles the RemoveSynonymGroup request to remove one of the tenant's synonym groups
+func (wordSearchSystemServer *WordSearchSystemServer) RemoveSynonymGroup(ctx context.Context, in *wordsearchsystemgrpc.RemoveSynonymGroupRequest) (*wordsearchsystemgrpc.RemoveSynonymGroupReply, error) {
+	tenant, err := wordSearchSystemServer.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = tenant.WordSearchService().Synonyms().RemoveGroup(in.Id)
+	if errors.Cause(err) == ErrSynonymGroupNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &wordsearchsystemgrpc.RemoveSynonymGroupReply{}, nil
+}
+
+//ListSynonymGroups - handles the ListSynonymGroups request to list the tenant's synonym groups in the order they were added
+func (wordSearchSystemServer *WordSearchSystemServer) ListSynonymGroups(ctx context.Context, in *wordsearchsystemgrpc.ListSynonymGroupsRequest) (*wordsearchsystemgrpc.ListSynonymGroupsReply, error) {
+	tenant, err := wordSearchSystemServer.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	groups := tenant.WordSearchService().Synonyms().ListGroups()
+	reply := &wordsearchsystemgrpc.ListSynonymGroupsReply{Groups: make([]*wordsearchsystemgrpc.SynonymGroup, len(groups))}
+	for i, group := range groups {
+		reply.Groups[i] = &wordsearchsystemgrpc.SynonymGroup{Id: group.ID, Words: group.Words}
+	}
+	return reply, nil
+}
+
+//IndexDocument - handles the IndexDocument request to index a document for full-text search
+func (wordSearchSystemServer *WordSearchSystemServer) IndexDocument(ctx context.Context, in *wordsearchsystemgrpc.IndexDocumentRequest) (*wordsearchsystemgrpc.IndexDocumentReply, error) {
+	tenant, err := wordSearchSystemServer.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = tenant.WordSearchService().Documents().IndexDocument(in.Id, in.Text)
+	if errors.Cause(err) == ErrWordLimitExceeded {
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &wordsearchsystemgrpc.IndexDocumentReply{}, nil
+}
+
+//DeleteDocument - handles the DeleteDocument request to remove a document from the full-text index
+func (wordSearchSystemServer *WordSearchSystemServer) DeleteDocument(ctx context.Context, in *wordsearchsystemgrpc.DeleteDocumentRequest) (*wordsearchsystemgrpc.DeleteDocumentReply, error) {
+	tenant, err := wordSearchSystemServer.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = tenant.WordSearchService().Documents().DeleteDocument(in.Id)
+	if errors.Cause(err) == ErrDocumentNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &wordsearchsystemgrpc.DeleteDocumentReply{}, nil
+}
+
+//SearchDocuments - handles the SearchDocuments request to find the documents best matching a query
+func (wordSearchSystemServer *WordSearchSystemServer) SearchDocuments(ctx context.Context, in *wordsearchsystemgrpc.SearchDocumentsRequest) (*wordsearchsystemgrpc.SearchDocumentsReply, error) {
+	tenant, err := wordSearchSystemServer.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	matches := tenant.WordSearchService().Documents().SearchDocuments(in.Query, int(in.Limit))
+	reply := &wordsearchsystemgrpc.SearchDocumentsReply{Matches: make([]*wordsearchsystemgrpc.DocumentMatch, len(matches))}
+	for i, match := range matches {
+		reply.Matches[i] = &wordsearchsystemgrpc.DocumentMatch{Id: match.ID, Score: match.Score}
+	}
+	return reply, nil
+}
+
+//ListAuditEvents - handles the ListAuditEvents request to list a page of the mutations made to the tenant's dictionaries
+func (wordSearchSystemServer *WordSearchSystemServer) ListAuditEvents(ctx context.Context, in *wordsearchsystemgrpc.ListAuditEventsRequest) (*wordsearchsystemgrpc.ListAuditEventsReply, error) {
+	tenant, err := wordSearchSystemServer.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	filter := AuditEventFilter{
+		TenantID:   tenant.ID(),
+		Dictionary: in.Dictionary,
+		Caller:     in.Caller,
+		Action:     in.Action,
+		Word:       in.Word,
+	}
+	if in.Since != nil {
+		if filter.Since, err = ptypes.Timestamp(in.Since); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if in.Until != nil {
+		if filter.Until, err = ptypes.Timestamp(in.Until); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	events, nextPageToken, err := wordSearchSystemServer.auditLog.ListAuditEvents(filter, int(in.PageSize), in.PageToken)
+	if err == ErrInvalidPageToken {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	reply := &wordsearchsystemgrpc.ListAuditEventsReply{Events: make([]*wordsearchsystemgrpc.AuditEvent, len(events)), NextPageToken: nextPageToken}
+	for i, event := range events {
+		eventTime, err := ptypes.TimestampProto(event.Time)
+		if err != nil {
+			return nil, err
+		}
+		reply.Events[i] = &wordsearchsystemgrpc.AuditEvent{
+			Id:          event.ID,
+			Time:        eventTime,
+			Caller:      event.Caller,
+			PeerAddress: event.PeerAddress,
+			RequestId:   event.RequestID,
+			Dictionary:  event.Dictionary,
+			Action:      event.Action,
+			Version:     event.Version,
+			Changes:     make([]*wordsearchsystemgrpc.AuditWordChange, len(event.Changes)),
+			Error:       event.Error,
+		}
+		for j, change := range event.Changes {
+			reply.Events[i].Changes[j] = &wordsearchsystemgrpc.AuditWordChange{Word: change.Word, ExistedBefore: change.ExistedBefore, ExistsAfter: change.ExistsAfter}
+		}
+	}
+	return reply, nil
+}
+
+//ListVersions - handles the ListVersions request to list the versions kept for a dictionary
+func (wordSearchSystemServer *WordSearchSystemServer) ListVersions(ctx context.Context, in *wordsearchsystemgrpc.ListVersionsRequest) (*wordsearchsystemgrpc.ListVersionsReply, error) {
+	_, dictionary, err := wordSearchSystemServer.dictionary(ctx, in.Dictionary)
+	if err != nil {
+		return nil, err
+	}
+	versions := dictionary.ListVersions()
+	reply := &wordsearchsystemgrpc.ListVersionsReply{Versions: make([]*wordsearchsystemgrpc.DictionaryVersion, len(versions))}
+	for i, version := range versions {
+		createdAt, err := ptypes.TimestampProto(version.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		reply.Versions[i] = &wordsearchsystemgrpc.DictionaryVersion{
+			Version:      version.Version,
+			CreatedAt:    createdAt,
+			Description:  version.Description,
+			WordsAdded:   int32(version.WordsAdded),
+			WordsUpdated: int32(version.WordsUpdated),
+			WordsRemoved: int32(version.WordsRemoved),
+		}
+	}
+	return reply, nil
+}
+
+//RollbackDictionary - handles the RollbackDictionary request to restore the words of an earlier version
+func (wordSearchSystemServer *WordSearchSystemServer) RollbackDictionary(ctx context.Context, in *wordsearchsystemgrpc.RollbackDictionaryRequest) (*wordsearchsystemgrpc.RollbackDictionaryReply, error) {
+	tenant, dictionary, err := wordSearchSystemServer.dictionary(ctx, in.Dictionary)
+	if err != nil {
+		return nil, err
+	}
+	version, err := dictionary.RollbackDictionary(in.Version)
+	wordSearchSystemServer.audit(ctx, tenant, dictionary, "RollbackDictionary", version, err)
+	if errors.Cause(err) == ErrVersionNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Cause(err) == ErrWordLimitExceeded {
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &wordsearchsystemgrpc.RollbackDictionaryReply{Version: version}, nil
+}
+
+//tenant - returns the tenant a request is made for. Requests which do not authenticate as a hosted tenant, or which are over the tenant's rate limit, are refused
+func (wordSearchSystemServer *WordSearchSystemServer) tenant(ctx context.Context) (*Tenant, error) {
+	tenant, err := wordSearchSystemServer.tenantRegistry.Authenticate(metadataValue(ctx, tenantMetadataKey), requestAPIKey(ctx))
+	switch errors.Cause(err) {
+	case nil:
+	case ErrInvalidAPIKey, ErrAPIKeyRequired:
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	case ErrTenantNotFound:
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return nil, err
+	}
+	if !tenant.AllowRequest() {
+		return nil, status.Error(codes.ResourceExhausted, "tenant "+tenant.ID()+" has exceeded its request rate limit")
+	}
+	return tenant, nil
+}
+
+//requestAPIKey - returns the API key a request carries, or "" if it carries none
+func requestAPIKey(ctx context.Context) string {
+	return strings.TrimPrefix(metadataValue(ctx, authorizationMetadataKey), "Bearer ")
+}
+
+//dictionary - returns the requesting tenant's dictionary with the given name, or its default dictionary if name is empty. See tenant for the requests which are refused
+func (wordSearchSystemServer *WordSearchSystemServer) dictionary(ctx context.Context, name string) (*Tenant, *WordDictionary, error) {
+	tenant, err := wordSearchSystemServer.tenant(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	dictionary, err := tenant.WordSearchService().Dictionary(name)
+	if err != nil {
+		return nil, nil, status.Error(codes.NotFound, err.Error())
+	}
+	return tenant, dictionary, nil
+}
+
+//audit - records a mutation of dictionary in the audit log, along with who made it and the words the version it produced changed.
+// mutationErr is the error the mutation failed with, if any, in which case nothing was changed
+func (wordSearchSystemServer *WordSearchSystemServer) audit(ctx context.Context, tenant *Tenant, dictionary *WordDictionary, action string, version int64, mutationErr error) {
+	event := AuditEvent{
+		Caller:     metadataValue(ctx, userMetadataKey),
+		RequestID:  metadataValue(ctx, requestIDMetadataKey),
+		TenantID:   tenant.ID(),
+		Dictionary: dictionary.Name(),
+		Action:     action,
+		Version:    version,
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		event.PeerAddress = p.Addr.String()
+	}
+	if event.Caller == "" {
+		event.Caller = event.PeerAddress
+	}
+	if event.RequestID == "" {
+		event.RequestID = newRequestID()
+	}
+	if mutationErr != nil {
+		event.Version = 0
+		event.Error = mutationErr.Error()
+	} else {
+		event.Changes = dictionary.AuditChanges(version)
+	}
+	if err := wordSearchSystemServer.auditLog.Append(event); err != nil {
+		log.Printf("failed to record audit event: %v", err)
+	}
+}
+
+//queryLogMetadataKeys - the request metadata which changes how a search is made, and so is kept in the query log
+var queryLogMetadataKeys = []string{sessionMetadataKey}
+
+//logQuery - records a search of dictionary in the query log, along with who made it. matchCount is the number of matches on every page of the search
+func (wordSearchSystemServer *WordSearchSystemServer) logQuery(ctx context.Context, tenant *Tenant, dictionary *WordDictionary, in *wordsearchsystemgrpc.SearchWordRequest, matchCount int, latency time.Duration) {
+	entry := QueryLogEntry{
+		Caller:              metadataValue(ctx, userMetadataKey),
+		TenantID:            tenant.ID(),
+		Dictionary:          dictionary.Name(),
+		KeyWord:             in.KeyWord,
+		Request:             in,
+		MatchCount:          matchCount,
+		LatencyMicroseconds: int64(latency / time.Microsecond),
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		entry.PeerAddress = p.Addr.String()
+	}
+	for _, key := range queryLogMetadataKeys {
+		if value := metadataValue(ctx, key); value != "" {
+			if entry.Metadata == nil {
+				entry.Metadata = make(map[string]string)
+			}
+			entry.Metadata[key] = value
+		}
+	}
+	if err := wordSearchSystemServer.queryLog.Record(entry); err != nil {
+		log.Printf("failed to record query: %v", err)
+	}
+}
+
+//addWordsOptions - converts the expiry fields of an AddWords or UpsertWords request to AddWordsOptions, or returns an InvalidArgument status if they are malformed
+func addWordsOptions(expiresAt *timestamp.Timestamp, ttl *duration.Duration) (options AddWordsOptions, err error) {
+	if expiresAt != nil {
+		if options.ExpiresAt, err = ptypes.Timestamp(expiresAt); err != nil {
+			return options, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if ttl != nil {
+		if options.TTL, err = ptypes.Duration(ttl); err != nil {
+			return options, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if options.TTL < 0 {
+			return options, status.Error(codes.InvalidArgument, "ttl must not be negative")
+		}
+	}
+	return options, nil
+}
+
+//addWordsStatus - converts an error adding words into a status: InvalidArgument with details for words which break the validation policy,
+// and ResourceExhausted for words which do not fit within the word limit
+func addWordsStatus(err error) error {
+	if validationErr, ok := err.(*WordValidationError); ok {
+		return wordValidationStatus(validationErr)
+	}
+	if errors.Cause(err) == ErrWordLimitExceeded {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	return err
+}
+
+//wordValidationStatus - converts err into an InvalidArgument status, with a BadRequest detail holding a field violation for each refused word
+func wordValidationStatus(err *WordValidationError) error {
+	badRequest := &errdetails.BadRequest{}
+	for _, violation := range err.Violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       fmt.Sprintf("words[%d]", violation.Index),
+			Description: violation.Reason,
+		})
+	}
+	_status, detailsErr := status.New(codes.InvalidArgument, err.Error()).WithDetails(badRequest)
+	if detailsErr != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return _status.Err()
+}
+
+//querySyntaxStatus - converts a query syntax error to an InvalidArgument status, detailing the column of the problem
+func querySyntaxStatus(err *QuerySyntaxError) error {
+	badRequest := &errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+			Field:       "query",
+			Description: fmt.Sprintf("column %d: %s", err.Column, err.Message),
+		}},
+	}
+	_status, detailsErr := status.New(codes.InvalidArgument, err.Error()).WithDetails(badRequest)
+	if detailsErr != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return _status.Err()
+}
+
+//newRequestID - generates a random id for a request which the client did not give an id
+func newRequestID() string {
+	id := make([]byte, 8)
+	rand.Read(id)
+	return hex.EncodeToString(id)
+}
+
+//metadataValue - returns the first value of key in the incoming request metadata, or "" if it was not sent
+func metadataValue(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
@@ -0,0 +1,887 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	wordsearchsystemgrpc "github.com/chrisjpalmer/word_search_system/word_search_system_grpc"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+//incomingContext - returns a context carrying the request metadata a client sent as key value pairs
+func incomingContext(keyValues ...string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(keyValues...))
+}
+
+//newTestServer - returns a server hosting the default tenant, an "acme" tenant authenticating with the API key "acme-key"
+// and an open "globex" tenant, with the given quota
+func newTestServer(t *testing.T, quota TenantQuota, wordValidator *WordValidator, queryLog *QueryLog) (*WordSearchSystemServer, *AuditLog) {
+	tenantRegistry := NewTenantRegistry(quota, wordValidator)
+	acmeKeyDigest := sha256.Sum256([]byte("acme-key"))
+	mustAddTenant(t, tenantRegistry, TenantConfig{ID: DefaultTenantID})
+	acmeAdminKeyDigest := sha256.Sum256([]byte("acme-admin-key"))
+	mustAddTenant(t, tenantRegistry, TenantConfig{
+		ID:                "acme",
+		APIKeySHA256:      []string{hex.EncodeToString(acmeKeyDigest[:])},
+		AdminAPIKeySHA256: []string{hex.EncodeToString(acmeAdminKeyDigest[:])},
+	})
+	mustAddTenant(t, tenantRegistry, TenantConfig{ID: "globex"})
+	auditLog := NewAuditLog(nil)
+	return NewWordSearchSystemServer(tenantRegistry, auditLog, queryLog), auditLog
+}
+
+//serveTestServer - serves server over gRPC on a local port and returns a client connected to it, along with a function which stops serving
+func serveTestServer(t *testing.T, server *WordSearchSystemServer) (wordsearchsystemgrpc.WordSearchSystemClient, func()) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	grpcServer := grpc.NewServer()
+	wordsearchsystemgrpc.RegisterWordSearchSystemServer(grpcServer, server)
+	go grpcServer.Serve(listener)
+	connection, err := grpc.Dial(listener.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	return wordsearchsystemgrpc.NewWordSearchSystemClient(connection), func() {
+		connection.Close()
+		grpcServer.Stop()
+	}
+}
+
+func TestWordSearchSystemServer(t *testing.T) {
+	t.Run("tenant and dictionary resolution", func(t *testing.T) {
+		server, _ := newTestServer(t, TenantQuota{}, nil, nil)
+
+		//it should serve the default tenant's default dictionary to a request without metadata
+		reply, err := server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "hell"})
+		assert.NoError(t, err)
+		assert.EqualValues(t, []string{"hello"}, reply.Matches)
+
+		//it should resolve the tenant from its API key
+		_, err = server.AddWords(incomingContext("authorization", "Bearer acme-key"), &wordsearchsystemgrpc.AddWordsRequest{Words: []string{"rocket"}})
+		assert.NoError(t, err)
+		reply, err = server.SearchWord(incomingContext("authorization", "Bearer acme-key", "tenant-id", "acme"), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "rock"})
+		assert.NoError(t, err)
+		assert.EqualValues(t, []string{"rocket"}, reply.Matches)
+
+		//it should refuse unknown tenants rather than create them, and tenants named with another tenant's key
+		_, err = server.SearchWord(incomingContext("tenant-id", "initech"), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "rock"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = server.SearchWord(incomingContext("tenant-id", "globex", "authorization", "Bearer acme-key"), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "rock"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		//it should refuse a tenant with API keys named without a valid key
+		_, err = server.SearchWord(incomingContext("tenant-id", "acme"), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "rock"})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		_, err = server.SearchWord(incomingContext("tenant-id", "acme", "authorization", "Bearer guess"), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "rock"})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		//it should refuse a dictionary the tenant does not have
+		_, err = server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "rock", Dictionary: "french"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("tenant isolation", func(t *testing.T) {
+		server, _ := newTestServer(t, TenantQuota{}, nil, nil)
+		acme := incomingContext("authorization", "Bearer acme-key")
+		globex := incomingContext("tenant-id", "globex")
+
+		server.SearchWord(acme, &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "secret"})
+
+		//it should not let one tenant read another tenant's keywords
+		reply, err := server.Top5SearchKeyWords(globex, &wordsearchsystemgrpc.Top5SearchKeyWordsRequest{})
+		assert.NoError(t, err)
+		assert.EqualValues(t, []string{}, reply.Keywords)
+		_, err = server.Top5SearchKeyWords(incomingContext("tenant-id", "acme"), &wordsearchsystemgrpc.Top5SearchKeyWordsRequest{})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		reply, err = server.Top5SearchKeyWords(acme, &wordsearchsystemgrpc.Top5SearchKeyWordsRequest{})
+		assert.NoError(t, err)
+		assert.EqualValues(t, []string{"secret"}, reply.Keywords)
+	})
+	t.Run("quotas", func(t *testing.T) {
+		//it should refuse words beyond the tenant's dictionary size limit (the default dictionary starts with 7 words)
+		server, _ := newTestServer(t, TenantQuota{MaxDictionaryWords: 8}, nil, nil)
+		_, err := server.AddWords(context.Background(), &wordsearchsystemgrpc.AddWordsRequest{Words: []string{"one"}})
+		assert.NoError(t, err)
+		_, err = server.AddWords(context.Background(), &wordsearchsystemgrpc.AddWordsRequest{Words: []string{"two"}})
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		//it should refuse words and documents beyond the tenant's total word limit, and dictionaries beyond its dictionary limit
+		server, _ = newTestServer(t, TenantQuota{MaxTenantWords: 9, MaxDictionaries: 2}, nil, nil)
+		_, err = server.CreateDictionary(context.Background(), &wordsearchsystemgrpc.CreateDictionaryRequest{Name: "french"})
+		assert.NoError(t, err)
+		_, err = server.CreateDictionary(context.Background(), &wordsearchsystemgrpc.CreateDictionaryRequest{Name: "german"})
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		_, err = server.AddWords(context.Background(), &wordsearchsystemgrpc.AddWordsRequest{Dictionary: "french", Words: []string{"un"}})
+		assert.NoError(t, err)
+		_, err = server.IndexDocument(context.Background(), &wordsearchsystemgrpc.IndexDocumentRequest{Id: "doc", Text: "deux mots"})
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		_, err = server.IndexDocument(context.Background(), &wordsearchsystemgrpc.IndexDocumentRequest{Id: "doc", Text: "mot"})
+		assert.NoError(t, err)
+		_, err = server.AddWords(context.Background(), &wordsearchsystemgrpc.AddWordsRequest{Dictionary: "french", Words: []string{"deux"}})
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		//it should refuse requests beyond the tenant's rate limit, without affecting other tenants
+		server, _ = newTestServer(t, TenantQuota{RequestsPerSecond: 1, RequestBurst: 2}, nil, nil)
+		for i := 0; i < 2; i++ {
+			_, err = server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "hell"})
+			assert.NoError(t, err)
+		}
+		_, err = server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "hell"})
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		_, err = server.SearchWord(incomingContext("tenant-id", "globex"), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "hell"})
+		assert.NoError(t, err)
+	})
+	t.Run("invalid words", func(t *testing.T) {
+		wordValidator, _ := NewWordValidator(ValidationPolicy{MaxLength: 5})
+		server, auditLog := newTestServer(t, TenantQuota{}, wordValidator, nil)
+
+		//it should refuse words which break the validation policy, and audit the refusal
+		_, err := server.AddWords(incomingContext("user-id", "alice", "x-request-id", "r1"), &wordsearchsystemgrpc.AddWordsRequest{Words: []string{"kiwi", "enormous", "gigantic"}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		//it should say which words were refused and why in a BadRequest detail
+		details := status.Convert(err).Details()
+		if assert.Len(t, details, 1) {
+			assert.EqualValues(t, []*errdetails.BadRequest_FieldViolation{
+				{Field: "words[1]", Description: "word is longer than 5 characters"},
+				{Field: "words[2]", Description: "word is longer than 5 characters"},
+			}, details[0].(*errdetails.BadRequest).FieldViolations)
+		}
+		events, _, _ := auditLog.ListAuditEvents(AuditEventFilter{}, 0, "")
+		if assert.Len(t, events, 1) {
+			assert.EqualValues(t, "alice", events[0].Caller)
+			assert.EqualValues(t, "r1", events[0].RequestID)
+			assert.EqualValues(t, "AddWords", events[0].Action)
+			assert.NotEmpty(t, events[0].Error)
+			assert.Empty(t, events[0].Changes)
+		}
+	})
+	t.Run("audit test", func(t *testing.T) {
+		server, auditLog := newTestServer(t, TenantQuota{}, nil, nil)
+
+		//it should audit each mutation with the tenant and dictionary it was made to, and generate a request id when there is none
+		_, err := server.AddWords(incomingContext("authorization", "Bearer acme-key", "user-id", "alice"), &wordsearchsystemgrpc.AddWordsRequest{Words: []string{"rocket"}})
+		assert.NoError(t, err)
+		events, _, _ := auditLog.ListAuditEvents(AuditEventFilter{}, 0, "")
+		if assert.Len(t, events, 1) {
+			assert.EqualValues(t, "acme", events[0].TenantID)
+			assert.EqualValues(t, DefaultDictionaryName, events[0].Dictionary)
+			assert.EqualValues(t, "alice", events[0].Caller)
+			assert.NotEmpty(t, events[0].RequestID)
+			assert.EqualValues(t, "", events[0].Error)
+			assert.EqualValues(t, []AuditWordChange{{Word: "rocket", ExistsAfter: true}}, events[0].Changes)
+		}
+
+		//it should record each word a batch repeats once
+		_, err = server.AddWords(context.Background(), &wordsearchsystemgrpc.AddWordsRequest{Words: []string{"kiwi", "KIWI", "lime"}})
+		assert.NoError(t, err)
+		events, _, _ = auditLog.ListAuditEvents(AuditEventFilter{}, 0, "")
+		if assert.Len(t, events, 2) {
+			assert.EqualValues(t, []AuditWordChange{{Word: "kiwi", ExistsAfter: true}, {Word: "lime", ExistsAfter: true}}, events[1].Changes)
+		}
+
+		//it should record no changes for a batch which is refused because a word already exists
+		_, err = server.AddWords(context.Background(), &wordsearchsystemgrpc.AddWordsRequest{Words: []string{"mango", "kiwi"}})
+		assert.Error(t, err)
+		events, _, _ = auditLog.ListAuditEvents(AuditEventFilter{}, 0, "")
+		if assert.Len(t, events, 3) {
+			assert.Empty(t, events[2].Changes)
+			assert.EqualValues(t, 0, events[2].Version)
+			assert.Contains(t, events[2].Error, "already exists")
+		}
+
+		//it should not audit searches
+		server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "rock"})
+		events, _, _ = auditLog.ListAuditEvents(AuditEventFilter{}, 0, "")
+		assert.Len(t, events, 3)
+	})
+	t.Run("query log test", func(t *testing.T) {
+		dir, _ := ioutil.TempDir("", "query_log")
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "queries.jsonl")
+		queryLog, err := NewQueryLog(QueryLogConfig{Path: path})
+		assert.NoError(t, err)
+		server, _ := newTestServer(t, TenantQuota{}, nil, queryLog)
+
+		//it should log each search with who made it and the metadata which changed it
+		server.SearchWord(incomingContext("tenant-id", "globex", "user-id", "alice", "session-id", "s1", "x-request-id", "r1"), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "hello", Stemming: true})
+		server.Top5SearchKeyWords(context.Background(), &wordsearchsystemgrpc.Top5SearchKeyWordsRequest{})
+		reply, _ := server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "o", PageSize: 1})
+		assert.NoError(t, queryLog.Close())
+		entries := readQueryLogEntries(t, path)
+		if assert.Len(t, entries, 2) {
+			assert.EqualValues(t, "globex", entries[0].TenantID)
+			assert.EqualValues(t, DefaultDictionaryName, entries[0].Dictionary)
+			assert.EqualValues(t, "alice", entries[0].Caller)
+			assert.EqualValues(t, "hello", entries[0].KeyWord)
+			assert.EqualValues(t, "hello", entries[0].Request.KeyWord)
+			assert.True(t, entries[0].Request.Stemming)
+			assert.EqualValues(t, 1, entries[0].MatchCount)
+			assert.EqualValues(t, map[string]string{"session-id": "s1"}, entries[0].Metadata)
+			assert.False(t, entries[0].Time.After(time.Now()))
+
+			//it should log the number of matches of every page, not just the page returned
+			assert.Len(t, reply.Matches, 1)
+			assert.EqualValues(t, 3, entries[1].MatchCount)
+			assert.EqualValues(t, reply.TotalMatches, entries[1].MatchCount)
+		}
+	})
+	t.Run("export test", func(t *testing.T) {
+		server, _ := newTestServer(t, TenantQuota{}, nil, nil)
+		client, stop := serveTestServer(t, server)
+		defer stop()
+		server.AddWords(incomingContext("authorization", "Bearer acme-key"), &wordsearchsystemgrpc.AddWordsRequest{Words: []string{"rocket", "comet"}})
+
+		//it should stream the tenant's dictionary in chunks of the requested size and format
+		stream, err := client.ExportWords(metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer acme-key"), &wordsearchsystemgrpc.ExportWordsRequest{Format: "jsonl", ChunkSize: 1})
+		assert.NoError(t, err)
+		chunks := make([]string, 0)
+		for {
+			reply, err := stream.Recv()
+			if err != nil {
+				break
+			}
+			chunks = append(chunks, string(reply.Chunk))
+		}
+		if assert.Len(t, chunks, 9) {
+			assert.EqualValues(t, "{\"word\":\"comet\"}\n", chunks[0])
+			assert.EqualValues(t, "{\"word\":\"rocket\"}\n", chunks[6])
+		}
+
+		//it should refuse an unknown format or dictionary
+		stream, _ = client.ExportWords(context.Background(), &wordsearchsystemgrpc.ExportWordsRequest{Format: "xml"})
+		_, err = stream.Recv()
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		stream, _ = client.ExportWords(context.Background(), &wordsearchsystemgrpc.ExportWordsRequest{Dictionary: "french"})
+		_, err = stream.Recv()
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		//it should download an export into a file, without the words of other tenants
+		dir, _ := ioutil.TempDir("", "export")
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "words.csv")
+		assert.NoError(t, DownloadWordsFile(context.Background(), client, DefaultDictionaryName, ExportFormatCSV, path))
+		exported, _ := ioutil.ReadFile(path)
+		assert.EqualValues(t, "word,definition,partOfSpeech,tags,attributes,expiresAt\nfilter,,,,,\ngoodbye,,,,,\nhello,,,,,\nlist,,,,,\nno,,,,,\nsearch,,,,,\nyes,,,,,\n", string(exported))
+		assert.Error(t, DownloadWordsFile(context.Background(), client, "french", ExportFormatCSV, path))
+
+		//it should download the export of a tenant with API keys when given its credentials
+		assert.Error(t, DownloadWordsFile(WithClientCredentials(context.Background(), "acme", ""), client, DefaultDictionaryName, ExportFormatText, path))
+		assert.NoError(t, DownloadWordsFile(WithClientCredentials(context.Background(), "acme", "acme-key"), client, DefaultDictionaryName, ExportFormatText, path))
+		exported, _ = ioutil.ReadFile(path)
+		assert.Contains(t, string(exported), "rocket\n")
+		assert.NoError(t, DownloadWordsFile(WithClientCredentials(context.Background(), "", "acme-key"), client, DefaultDictionaryName, ExportFormatText, path))
+		assert.Error(t, DownloadWordsFile(WithClientCredentials(context.Background(), "globex", "acme-key"), client, DefaultDictionaryName, ExportFormatText, path))
+	})
+	t.Run("add words stream test", func(t *testing.T) {
+		wordValidator, _ := NewWordValidator(ValidationPolicy{MaxLength: 6})
+		server, auditLog := newTestServer(t, TenantQuota{}, wordValidator, nil)
+		client, stop := serveTestServer(t, server)
+		defer stop()
+
+		//it should add the words of every chunk, counting duplicates and refused words, and audit each batch
+		stream, err := client.AddWordsStream(metadata.AppendToOutgoingContext(context.Background(), "user-id", "alice"))
+		assert.NoError(t, err)
+		stream.Send(&wordsearchsystemgrpc.AddWordsStreamRequest{Words: []string{"kiwi", "lime", "hello"}})
+		stream.Send(&wordsearchsystemgrpc.AddWordsStreamRequest{Words: []string{"kiwi", "enormous", "mango"}})
+		reply, err := stream.CloseAndRecv()
+		assert.NoError(t, err)
+		assert.EqualValues(t, &wordsearchsystemgrpc.AddWordsStreamReply{Added: 3, Duplicates: 2, Rejected: 1}, reply)
+		searchReply, _ := server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "mango"})
+		assert.EqualValues(t, []string{"mango"}, searchReply.Matches)
+		events, _, _ := auditLog.ListAuditEvents(AuditEventFilter{}, 0, "")
+		if assert.Len(t, events, 1) {
+			assert.EqualValues(t, "AddWordsStream", events[0].Action)
+			assert.EqualValues(t, "alice", events[0].Caller)
+			assert.Len(t, events[0].Changes, 3)
+		}
+
+		//it should reply with an empty summary to an empty stream, and refuse an unknown dictionary
+		stream, _ = client.AddWordsStream(context.Background())
+		reply, err = stream.CloseAndRecv()
+		assert.NoError(t, err)
+		assert.EqualValues(t, &wordsearchsystemgrpc.AddWordsStreamReply{}, reply)
+		stream, _ = client.AddWordsStream(context.Background())
+		stream.Send(&wordsearchsystemgrpc.AddWordsStreamRequest{Dictionary: "french", Words: []string{"pomme"}})
+		_, err = stream.CloseAndRecv()
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("dictionaries test", func(t *testing.T) {
+		server, auditLog := newTestServer(t, TenantQuota{}, nil, nil)
+		globex := incomingContext("tenant-id", "globex")
+
+		//it should create dictionaries for the tenant, with their own words
+		_, err := server.CreateDictionary(context.Background(), &wordsearchsystemgrpc.CreateDictionaryRequest{Name: "french"})
+		assert.NoError(t, err)
+		_, err = server.CreateDictionary(context.Background(), &wordsearchsystemgrpc.CreateDictionaryRequest{Name: "french"})
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+		_, err = server.CreateDictionary(context.Background(), &wordsearchsystemgrpc.CreateDictionaryRequest{Name: " spaced "})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = server.AddWords(context.Background(), &wordsearchsystemgrpc.AddWordsRequest{Words: []string{"bonjour"}, Dictionary: "french"})
+		assert.NoError(t, err)
+		reply, _ := server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "o", Dictionary: "french"})
+		assert.EqualValues(t, []string{"bonjour"}, reply.Matches)
+		keyWordsReply, _ := server.Top5SearchKeyWords(context.Background(), &wordsearchsystemgrpc.Top5SearchKeyWordsRequest{Dictionary: "french"})
+		assert.EqualValues(t, []string{"o"}, keyWordsReply.Keywords)
+		keyWordsReply, _ = server.Top5SearchKeyWords(context.Background(), &wordsearchsystemgrpc.Top5SearchKeyWordsRequest{})
+		assert.EqualValues(t, []string{}, keyWordsReply.Keywords)
+
+		//it should list only the tenant's own dictionaries
+		listReply, err := server.ListDictionaries(context.Background(), &wordsearchsystemgrpc.ListDictionariesRequest{})
+		assert.NoError(t, err)
+		assert.EqualValues(t, []string{DefaultDictionaryName, "french"}, listReply.Names)
+		listReply, _ = server.ListDictionaries(globex, &wordsearchsystemgrpc.ListDictionariesRequest{})
+		assert.EqualValues(t, []string{DefaultDictionaryName}, listReply.Names)
+
+		//it should delete dictionaries other than the default dictionary, auditing the creation and deletion
+		_, err = server.DeleteDictionary(globex, &wordsearchsystemgrpc.DeleteDictionaryRequest{Name: "french"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		_, err = server.DeleteDictionary(context.Background(), &wordsearchsystemgrpc.DeleteDictionaryRequest{Name: DefaultDictionaryName})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		_, err = server.DeleteDictionary(context.Background(), &wordsearchsystemgrpc.DeleteDictionaryRequest{Name: "french"})
+		assert.NoError(t, err)
+		_, err = server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "o", Dictionary: "french"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		events, _, _ := auditLog.ListAuditEvents(AuditEventFilter{Dictionary: "french"}, 0, "")
+		if assert.Len(t, events, 3) {
+			assert.EqualValues(t, "CreateDictionary", events[0].Action)
+			assert.EqualValues(t, "DeleteDictionary", events[2].Action)
+			assert.Empty(t, events[2].Error)
+		}
+	})
+	t.Run("versions test", func(t *testing.T) {
+		server, auditLog := newTestServer(t, TenantQuota{}, nil, nil)
+
+		//it should reply with the version each mutation produced, and list the versions
+		addReply, err := server.AddWords(context.Background(), &wordsearchsystemgrpc.AddWordsRequest{Words: []string{"kiwi"}})
+		assert.NoError(t, err)
+		_, err = server.AddWords(context.Background(), &wordsearchsystemgrpc.AddWordsRequest{Words: []string{"lime", "mango"}})
+		assert.NoError(t, err)
+		listReply, err := server.ListVersions(context.Background(), &wordsearchsystemgrpc.ListVersionsRequest{})
+		assert.NoError(t, err)
+		if assert.Len(t, listReply.Versions, 3) {
+			assert.EqualValues(t, addReply.Version, listReply.Versions[1].Version)
+			assert.EqualValues(t, 2, listReply.Versions[2].WordsAdded)
+			assert.NotNil(t, listReply.Versions[2].CreatedAt)
+		}
+
+		//it should search the dictionary as it was at a pinned version
+		reply, err := server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "i", Version: addReply.Version})
+		assert.NoError(t, err)
+		assert.EqualValues(t, []string{"filter", "kiwi", "list"}, reply.Matches)
+		assert.EqualValues(t, addReply.Version, reply.Version)
+		_, err = server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "i", Version: 1000})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		//it should roll back to an earlier version as a new, audited version
+		rollbackReply, err := server.RollbackDictionary(context.Background(), &wordsearchsystemgrpc.RollbackDictionaryRequest{Version: addReply.Version})
+		assert.NoError(t, err)
+		reply, _ = server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "i"})
+		assert.EqualValues(t, []string{"filter", "kiwi", "list"}, reply.Matches)
+		assert.EqualValues(t, rollbackReply.Version, reply.Version)
+		_, err = server.RollbackDictionary(context.Background(), &wordsearchsystemgrpc.RollbackDictionaryRequest{Version: 1000})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		events, _, _ := auditLog.ListAuditEvents(AuditEventFilter{Action: "RollbackDictionary"}, 0, "")
+		if assert.Len(t, events, 2) {
+			assert.EqualValues(t, rollbackReply.Version, events[0].Version)
+			assert.Len(t, events[0].Changes, 2)
+		}
+	})
+	t.Run("metadata test", func(t *testing.T) {
+		server, auditLog := newTestServer(t, TenantQuota{MaxDictionaryWords: 9}, nil, nil)
+
+		//it should upsert words with their metadata, auditing the upsert
+		upsertReply, err := server.UpsertWords(context.Background(), &wordsearchsystemgrpc.UpsertWordsRequest{Words: []*wordsearchsystemgrpc.WordEntry{
+			{Word: "bank", Metadata: &wordsearchsystemgrpc.WordMetadata{Definition: "a financial institution", PartOfSpeech: "noun", Tags: []string{"finance"}}},
+			{Word: "bankrupt", Metadata: &wordsearchsystemgrpc.WordMetadata{PartOfSpeech: "adjective", Attributes: map[string]string{"register": "formal"}}},
+			{Word: "hello"},
+		}})
+		assert.NoError(t, err)
+		assert.NotZero(t, upsertReply.Version)
+		events, _, _ := auditLog.ListAuditEvents(AuditEventFilter{Action: "UpsertWords"}, 0, "")
+		if assert.Len(t, events, 1) {
+			assert.Len(t, events[0].Changes, 3)
+		}
+
+		//it should return metadata with the results only when asked for, and filter by it
+		reply, err := server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "bank", IncludeMetadata: true})
+		assert.NoError(t, err)
+		assert.EqualValues(t, []string{"bank", "bankrupt"}, reply.Matches)
+		if assert.Len(t, reply.Results, 2) {
+			assert.EqualValues(t, "a financial institution", reply.Results[0].Metadata.Definition)
+			assert.EqualValues(t, map[string]string{"register": "formal"}, reply.Results[1].Metadata.Attributes)
+		}
+		reply, _ = server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "bank"})
+		assert.Nil(t, reply.Results[0].Metadata)
+		reply, _ = server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "", PartOfSpeech: "Noun", Tags: []string{"finance"}})
+		assert.EqualValues(t, []string{"bank"}, reply.Matches)
+
+		//it should refuse invalid words and words beyond the word limit
+		_, err = server.UpsertWords(context.Background(), &wordsearchsystemgrpc.UpsertWordsRequest{Words: []*wordsearchsystemgrpc.WordEntry{{Word: " "}}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = server.UpsertWords(context.Background(), &wordsearchsystemgrpc.UpsertWordsRequest{Words: []*wordsearchsystemgrpc.WordEntry{{Word: "overdraft"}}})
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+	t.Run("validate words test", func(t *testing.T) {
+		wordValidator, _ := NewWordValidator(ValidationPolicy{MaxLength: 5})
+		server, auditLog := newTestServer(t, TenantQuota{MaxDictionaryWords: 8}, wordValidator, nil)
+
+		//it should report the words which would be refused and whether the rest fit, without adding or auditing anything
+		reply, err := server.ValidateWords(context.Background(), &wordsearchsystemgrpc.ValidateWordsRequest{Words: []string{"kiwi", "enormous", "hello", "lime"}})
+		assert.NoError(t, err)
+		assert.EqualValues(t, []*wordsearchsystemgrpc.WordViolation{
+			{Index: 1, Word: "enormous", Reason: "word is longer than 5 characters"},
+			{Index: 2, Word: "hello", Reason: "word already exists"},
+		}, reply.Violations)
+		assert.True(t, reply.ExceedsWordLimit)
+		reply, _ = server.ValidateWords(context.Background(), &wordsearchsystemgrpc.ValidateWordsRequest{Words: []string{"kiwi"}})
+		assert.Empty(t, reply.Violations)
+		assert.False(t, reply.ExceedsWordLimit)
+		searchReply, _ := server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "kiwi"})
+		assert.Empty(t, searchReply.Matches)
+		events, _, _ := auditLog.ListAuditEvents(AuditEventFilter{}, 0, "")
+		assert.Empty(t, events)
+	})
+	t.Run("blocklist test", func(t *testing.T) {
+		server, _ := newTestServer(t, TenantQuota{}, nil, nil)
+		globex := incomingContext("tenant-id", "globex")
+		server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "hello"})
+
+		//it should block terms for the tenant, hiding them from searches and keyword rankings and refusing to add them
+		_, err := server.AddBlockedTerms(context.Background(), &wordsearchsystemgrpc.AddBlockedTermsRequest{Terms: []string{"Hello", "darn"}})
+		assert.NoError(t, err)
+		listReply, _ := server.ListBlockedTerms(context.Background(), &wordsearchsystemgrpc.ListBlockedTermsRequest{})
+		assert.EqualValues(t, []string{"darn", "hello"}, listReply.Terms)
+		reply, _ := server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "hell"})
+		assert.Empty(t, reply.Matches)
+		keyWordsReply, _ := server.Top5SearchKeyWords(context.Background(), &wordsearchsystemgrpc.Top5SearchKeyWordsRequest{})
+		assert.EqualValues(t, []string{"hell"}, keyWordsReply.Keywords)
+		_, err = server.AddWords(context.Background(), &wordsearchsystemgrpc.AddWordsRequest{Words: []string{"darn"}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		//it should not block the terms for other tenants
+		reply, _ = server.SearchWord(globex, &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "hell"})
+		assert.EqualValues(t, []string{"hello"}, reply.Matches)
+		listReply, _ = server.ListBlockedTerms(globex, &wordsearchsystemgrpc.ListBlockedTermsRequest{})
+		assert.Empty(t, listReply.Terms)
+
+		//it should unblock terms
+		_, err = server.RemoveBlockedTerms(context.Background(), &wordsearchsystemgrpc.RemoveBlockedTermsRequest{Terms: []string{"hello"}})
+		assert.NoError(t, err)
+		reply, _ = server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "hell"})
+		assert.EqualValues(t, []string{"hello"}, reply.Matches)
+	})
+	t.Run("expiry test", func(t *testing.T) {
+		server, _ := newTestServer(t, TenantQuota{}, nil, nil)
+		tenant, _ := server.tenantRegistry.Tenant(DefaultTenantID)
+		dictionary, _ := tenant.WordSearchService().Dictionary("")
+		now := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+		dictionary.now = func() time.Time { return now }
+		expiresAt, _ := ptypes.TimestampProto(now.Add(2 * time.Hour))
+
+		//it should add words which expire at a time, or after a ttl
+		_, err := server.AddWords(context.Background(), &wordsearchsystemgrpc.AddWordsRequest{Words: []string{"ephemeral"}, ExpiresAt: expiresAt})
+		assert.NoError(t, err)
+		_, err = server.UpsertWords(context.Background(), &wordsearchsystemgrpc.UpsertWordsRequest{Words: []*wordsearchsystemgrpc.WordEntry{{Word: "fleeting", Ttl: ptypes.DurationProto(time.Hour)}}})
+		assert.NoError(t, err)
+		reply, _ := server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "e"})
+		assert.Contains(t, reply.Matches, "ephemeral")
+		assert.Contains(t, reply.Matches, "fleeting")
+		now = now.Add(time.Hour)
+		reply, _ = server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "e"})
+		assert.Contains(t, reply.Matches, "ephemeral")
+		assert.NotContains(t, reply.Matches, "fleeting")
+		now = now.Add(time.Hour)
+		reply, _ = server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "e"})
+		assert.NotContains(t, reply.Matches, "ephemeral")
+
+		//it should refuse a negative ttl
+		_, err = server.AddWords(context.Background(), &wordsearchsystemgrpc.AddWordsRequest{Words: []string{"backwards"}, Ttl: ptypes.DurationProto(-time.Hour)})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("list audit events test", func(t *testing.T) {
+		server, _ := newTestServer(t, TenantQuota{}, nil, nil)
+		acme := incomingContext("authorization", "Bearer acme-key", "user-id", "alice")
+		server.AddWords(acme, &wordsearchsystemgrpc.AddWordsRequest{Words: []string{"anvil"}})
+		server.AddWords(acme, &wordsearchsystemgrpc.AddWordsRequest{Words: []string{"rocket"}})
+		server.AddWords(acme, &wordsearchsystemgrpc.AddWordsRequest{Words: []string{"rocket"}})
+		server.AddWords(incomingContext("tenant-id", "globex"), &wordsearchsystemgrpc.AddWordsRequest{Words: []string{"anvil"}})
+
+		//it should list a page of the tenant's events with a token for the next page
+		reply, err := server.ListAuditEvents(acme, &wordsearchsystemgrpc.ListAuditEventsRequest{PageSize: 2})
+		assert.NoError(t, err)
+		assert.Len(t, reply.Events, 2)
+		assert.EqualValues(t, "alice", reply.Events[0].Caller)
+		assert.EqualValues(t, "AddWords", reply.Events[0].Action)
+		assert.EqualValues(t, []*wordsearchsystemgrpc.AuditWordChange{{Word: "anvil", ExistsAfter: true}}, reply.Events[0].Changes)
+		assert.NotNil(t, reply.Events[0].Time)
+		assert.NotEmpty(t, reply.NextPageToken)
+		reply, err = server.ListAuditEvents(acme, &wordsearchsystemgrpc.ListAuditEventsRequest{PageSize: 2, PageToken: reply.NextPageToken})
+		assert.NoError(t, err)
+		assert.Len(t, reply.Events, 1)
+		assert.NotEmpty(t, reply.Events[0].Error)
+		assert.Empty(t, reply.NextPageToken)
+
+		//it should filter the events
+		reply, _ = server.ListAuditEvents(acme, &wordsearchsystemgrpc.ListAuditEventsRequest{Word: "anvil"})
+		assert.Len(t, reply.Events, 1)
+		reply, _ = server.ListAuditEvents(acme, &wordsearchsystemgrpc.ListAuditEventsRequest{Caller: "bob"})
+		assert.Empty(t, reply.Events)
+
+		//it should refuse a page token which was not produced by a previous call
+		_, err = server.ListAuditEvents(acme, &wordsearchsystemgrpc.ListAuditEventsRequest{PageToken: "not a token"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("search pagination test", func(t *testing.T) {
+		server, _ := newTestServer(t, TenantQuota{}, nil, nil)
+		words := make([]string, 0, 26*26)
+		for _, second := range "abcdefghijklmnopqrstuvwxyz" {
+			for _, third := range "abcdefghijklmnopqrstuvwxyz" {
+				words = append(words, "e"+string(second)+string(third))
+			}
+		}
+		_, err := server.AddWords(context.Background(), &wordsearchsystemgrpc.AddWordsRequest{Words: words})
+		assert.NoError(t, err)
+
+		//it should return every match when the client does not ask for a page size, as it did before pagination
+		reply, err := server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "e"})
+		assert.NoError(t, err)
+		assert.Len(t, reply.Matches, len(words)+5)
+		assert.Len(t, reply.Results, len(words)+5)
+		assert.EqualValues(t, len(words)+5, reply.TotalMatches)
+		assert.Empty(t, reply.NextPageToken)
+
+		//it should page through every match without repeating any, even as words are added between pages
+		seen := make(map[string]bool)
+		request := &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "e", PageSize: 300}
+		for {
+			reply, err = server.SearchWord(context.Background(), request)
+			assert.NoError(t, err)
+			for _, match := range reply.Matches {
+				assert.False(t, seen[match], match)
+				seen[match] = true
+			}
+			if reply.NextPageToken == "" {
+				break
+			}
+			request.PageToken = reply.NextPageToken
+			server.AddWords(context.Background(), &wordsearchsystemgrpc.AddWordsRequest{Words: []string{"eaaa" + string(rune('a'+len(seen)%26))}})
+		}
+		assert.True(t, len(seen) >= len(words)+5)
+
+		//it should refuse a page token which was not produced by a previous search
+		_, err = server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "e", PageToken: "not a token"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("search stream test", func(t *testing.T) {
+		server, _ := newTestServer(t, TenantQuota{}, nil, nil)
+		client, stop := serveTestServer(t, server)
+		defer stop()
+
+		//it should stream the matches in alphabetical batches of the requested size
+		stream, err := client.SearchWordStream(context.Background(), &wordsearchsystemgrpc.SearchWordStreamRequest{KeyWord: "e", BatchSize: 2})
+		assert.NoError(t, err)
+		batches := make([][]string, 0)
+		for {
+			reply, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			assert.NoError(t, err)
+			if err != nil {
+				break
+			}
+			assert.Len(t, reply.Results, len(reply.Matches))
+			batches = append(batches, reply.Matches)
+		}
+		assert.EqualValues(t, [][]string{{"filter", "goodbye"}, {"hello", "search"}, {"yes"}}, batches)
+
+		//it should fail for a dictionary which does not exist
+		stream, _ = client.SearchWordStream(context.Background(), &wordsearchsystemgrpc.SearchWordStreamRequest{KeyWord: "e", Dictionary: "missing"})
+		_, err = stream.Recv()
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("relevance test", func(t *testing.T) {
+		server, _ := newTestServer(t, TenantQuota{}, nil, nil)
+		server.AddWords(context.Background(), &wordsearchsystemgrpc.AddWordsRequest{Words: []string{"researcher"}})
+
+		//it should order matches alphabetically without scores by default
+		reply, _ := server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "search"})
+		assert.EqualValues(t, []string{"researcher", "search"}, reply.Matches)
+		assert.Zero(t, reply.Results[0].Score)
+
+		//it should rank matches by relevance, highest score first, when asked to
+		reply, _ = server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "search", RankByRelevance: true})
+		assert.EqualValues(t, []string{"search", "researcher"}, reply.Matches)
+		assert.True(t, reply.Results[0].Score > reply.Results[1].Score)
+	})
+	t.Run("highlights test", func(t *testing.T) {
+		server, _ := newTestServer(t, TenantQuota{}, nil, nil)
+		server.AddWords(context.Background(), &wordsearchsystemgrpc.AddWordsRequest{Words: []string{"Café-café"}})
+
+		//it should return the rune offsets of every matching span when asked to
+		reply, _ := server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "CAFÉ", IncludeHighlights: true})
+		assert.EqualValues(t, []string{"café-café"}, reply.Matches)
+		assert.EqualValues(t, []*wordsearchsystemgrpc.HighlightSpan{{Start: 0, End: 4}, {Start: 5, End: 9}}, reply.Results[0].Highlights)
+
+		//it should leave the highlights out otherwise
+		reply, _ = server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "café"})
+		assert.Empty(t, reply.Results[0].Highlights)
+	})
+	t.Run("query words test", func(t *testing.T) {
+		server, _ := newTestServer(t, TenantQuota{}, nil, nil)
+		server.AddWords(context.Background(), &wordsearchsystemgrpc.AddWordsRequest{Words: []string{"prefix", "preview", "suffix"}})
+
+		//it should return the words matching the query
+		reply, err := server.QueryWords(context.Background(), &wordsearchsystemgrpc.QueryWordsRequest{Query: "pre* AND NOT *fix"})
+		assert.NoError(t, err)
+		assert.EqualValues(t, []string{"preview"}, reply.Matches)
+
+		//it should refuse a query which cannot be parsed, with the column of the problem
+		_, err = server.QueryWords(context.Background(), &wordsearchsystemgrpc.QueryWordsRequest{Query: "(cat OR dog"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		details := status.Convert(err).Details()
+		if assert.Len(t, details, 1) {
+			violation := details[0].(*errdetails.BadRequest).FieldViolations[0]
+			assert.EqualValues(t, "query", violation.Field)
+			assert.Contains(t, violation.Description, "column 12")
+		}
+	})
+	t.Run("stemming test", func(t *testing.T) {
+		server, _ := newTestServer(t, TenantQuota{}, nil, nil)
+		server.AddWords(context.Background(), &wordsearchsystemgrpc.AddWordsRequest{Words: []string{"run", "runs", "rune"}})
+
+		//it should match the words sharing the keyword's stem, in their original forms, when asked to
+		reply, _ := server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "running", Stemming: true})
+		assert.EqualValues(t, []string{"run", "runs"}, reply.Matches)
+
+		//it should only match substrings otherwise
+		reply, _ = server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "running"})
+		assert.Empty(t, reply.Matches)
+	})
+	t.Run("synonyms test", func(t *testing.T) {
+		server, _ := newTestServer(t, TenantQuota{}, nil, nil)
+		server.AddWords(context.Background(), &wordsearchsystemgrpc.AddWordsRequest{Words: []string{"car", "automobile"}})
+
+		//it should add synonym groups for the tenant
+		addReply, err := server.AddSynonymGroup(context.Background(), &wordsearchsystemgrpc.AddSynonymGroupRequest{Words: []string{"car", "Automobile", "vehicle"}})
+		assert.NoError(t, err)
+		listReply, _ := server.ListSynonymGroups(context.Background(), &wordsearchsystemgrpc.ListSynonymGroupsRequest{})
+		assert.EqualValues(t, []*wordsearchsystemgrpc.SynonymGroup{{Id: addReply.Id, Words: []string{"automobile", "car", "vehicle"}}}, listReply.Groups)
+		listReply, _ = server.ListSynonymGroups(incomingContext("tenant-id", "globex"), &wordsearchsystemgrpc.ListSynonymGroupsRequest{})
+		assert.Empty(t, listReply.Groups)
+		_, err = server.AddSynonymGroup(context.Background(), &wordsearchsystemgrpc.AddSynonymGroupRequest{Words: []string{"car"}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		//it should expand searches with the synonyms when asked to, saying which synonym each match came through
+		reply, _ := server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "car", ExpandSynonyms: true})
+		assert.EqualValues(t, []string{"automobile", "car"}, reply.Matches)
+		assert.EqualValues(t, "automobile", reply.Results[0].ExpandedFrom)
+		assert.Empty(t, reply.Results[1].ExpandedFrom)
+		reply, _ = server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "car"})
+		assert.EqualValues(t, []string{"car"}, reply.Matches)
+
+		//it should remove synonym groups
+		_, err = server.RemoveSynonymGroup(context.Background(), &wordsearchsystemgrpc.RemoveSynonymGroupRequest{Id: addReply.Id})
+		assert.NoError(t, err)
+		_, err = server.RemoveSynonymGroup(context.Background(), &wordsearchsystemgrpc.RemoveSynonymGroupRequest{Id: addReply.Id})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		reply, _ = server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "car", ExpandSynonyms: true})
+		assert.EqualValues(t, []string{"car"}, reply.Matches)
+	})
+	t.Run("documents test", func(t *testing.T) {
+		server, _ := newTestServer(t, TenantQuota{}, nil, nil)
+
+		//it should index documents and rank them by how well they match the query
+		_, err := server.IndexDocument(context.Background(), &wordsearchsystemgrpc.IndexDocumentRequest{Id: "a", Text: "The quick brown fox"})
+		assert.NoError(t, err)
+		_, err = server.IndexDocument(context.Background(), &wordsearchsystemgrpc.IndexDocumentRequest{Id: "b", Text: "Fox, fox and more FOX"})
+		assert.NoError(t, err)
+		reply, _ := server.SearchDocuments(context.Background(), &wordsearchsystemgrpc.SearchDocumentsRequest{Query: "fox"})
+		if assert.Len(t, reply.Matches, 2) {
+			assert.EqualValues(t, "b", reply.Matches[0].Id)
+			assert.True(t, reply.Matches[0].Score > reply.Matches[1].Score)
+		}
+		reply, _ = server.SearchDocuments(context.Background(), &wordsearchsystemgrpc.SearchDocumentsRequest{Query: "fox", Limit: 1})
+		assert.Len(t, reply.Matches, 1)
+		reply, _ = server.SearchDocuments(incomingContext("tenant-id", "globex"), &wordsearchsystemgrpc.SearchDocumentsRequest{Query: "fox"})
+		assert.Empty(t, reply.Matches)
+		_, err = server.IndexDocument(context.Background(), &wordsearchsystemgrpc.IndexDocumentRequest{Text: "no id"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		//it should delete documents
+		_, err = server.DeleteDocument(context.Background(), &wordsearchsystemgrpc.DeleteDocumentRequest{Id: "b"})
+		assert.NoError(t, err)
+		_, err = server.DeleteDocument(context.Background(), &wordsearchsystemgrpc.DeleteDocumentRequest{Id: "b"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		reply, _ = server.SearchDocuments(context.Background(), &wordsearchsystemgrpc.SearchDocumentsRequest{Query: "fox"})
+		if assert.Len(t, reply.Matches, 1) {
+			assert.EqualValues(t, "a", reply.Matches[0].Id)
+		}
+	})
+	t.Run("text test", func(t *testing.T) {
+		server, auditLog := newTestServer(t, TenantQuota{}, nil, nil)
+
+		//it should return the distinct words of a text, leaving out stopwords, with their frequencies when asked to
+		tokenizeReply, err := server.TokenizeText(context.Background(), &wordsearchsystemgrpc.TokenizeTextRequest{Text: "The cat sat on the mat. The CAT was happy.", IncludeFrequencies: true})
+		assert.NoError(t, err)
+		assert.EqualValues(t, []*wordsearchsystemgrpc.TextWord{{Word: "cat", Frequency: 2}, {Word: "sat", Frequency: 1}, {Word: "mat", Frequency: 1}, {Word: "happy", Frequency: 1}}, tokenizeReply.Words)
+		tokenizeReply, _ = server.TokenizeText(context.Background(), &wordsearchsystemgrpc.TokenizeTextRequest{Text: "The cat sat", Stopwords: []string{"Cat"}})
+		assert.EqualValues(t, []*wordsearchsystemgrpc.TextWord{{Word: "the"}, {Word: "sat"}}, tokenizeReply.Words)
+
+		//it should add the words of a text, counting those already in the dictionary, and audit the change
+		addReply, err := server.AddWordsFromText(context.Background(), &wordsearchsystemgrpc.AddWordsFromTextRequest{Text: "Hello cat, hello mat"})
+		assert.NoError(t, err)
+		assert.EqualValues(t, 2, addReply.Added)
+		assert.EqualValues(t, 1, addReply.Duplicates)
+		assert.NotZero(t, addReply.Version)
+		events, _, _ := auditLog.ListAuditEvents(AuditEventFilter{Action: "AddWordsFromText"}, 0, "")
+		if assert.Len(t, events, 1) {
+			assert.EqualValues(t, addReply.Version, events[0].Version)
+			assert.Len(t, events[0].Changes, 2)
+		}
+
+		//it should not audit a text which added nothing
+		addReply, _ = server.AddWordsFromText(context.Background(), &wordsearchsystemgrpc.AddWordsFromTextRequest{Text: "cat"})
+		assert.Zero(t, addReply.Version)
+		events, _, _ = auditLog.ListAuditEvents(AuditEventFilter{Action: "AddWordsFromText"}, 0, "")
+		assert.Len(t, events, 1)
+	})
+	t.Run("check text test", func(t *testing.T) {
+		server, _ := newTestServer(t, TenantQuota{}, nil, nil)
+
+		//it should return the misspelled words with their offsets and corrections, closest first
+		reply, err := server.CheckText(context.Background(), &wordsearchsystemgrpc.CheckTextRequest{Text: "Café: helo, goodby", MaxSuggestions: 1})
+		assert.NoError(t, err)
+		if assert.Len(t, reply.Issues, 3) {
+			assert.EqualValues(t, &wordsearchsystemgrpc.SpellingIssue{Word: "Café", ByteStart: 0, ByteEnd: 5, RuneStart: 0, RuneEnd: 4, Suggestions: []*wordsearchsystemgrpc.SpellingSuggestion{}}, reply.Issues[0])
+			assert.EqualValues(t, &wordsearchsystemgrpc.SpellingIssue{Word: "helo", ByteStart: 7, ByteEnd: 11, RuneStart: 6, RuneEnd: 10, Suggestions: []*wordsearchsystemgrpc.SpellingSuggestion{{Word: "hello", Distance: 1}}}, reply.Issues[1])
+			assert.EqualValues(t, "goodby", reply.Issues[2].Word)
+			assert.EqualValues(t, []*wordsearchsystemgrpc.SpellingSuggestion{{Word: "goodbye", Distance: 1}}, reply.Issues[2].Suggestions)
+		}
+
+		//it should fail for a dictionary which does not exist
+		_, err = server.CheckText(context.Background(), &wordsearchsystemgrpc.CheckTextRequest{Text: "helo", Dictionary: "missing"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("match mode test", func(t *testing.T) {
+		server, _ := newTestServer(t, TenantQuota{}, nil, nil)
+		server.AddWords(context.Background(), &wordsearchsystemgrpc.AddWordsRequest{Words: []string{"ice cream", "cream cheese", "scream"}})
+
+		//it should match substrings by default
+		reply, _ := server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "cre"})
+		assert.EqualValues(t, []string{"cream cheese", "ice cream", "scream"}, reply.Matches)
+
+		//it should match phrases token by token when asked to
+		reply, _ = server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "cre", MatchMode: wordsearchsystemgrpc.MatchMode_MATCH_PHRASE_PREFIX})
+		assert.EqualValues(t, []string{"cream cheese"}, reply.Matches)
+		reply, _ = server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "cre", MatchMode: wordsearchsystemgrpc.MatchMode_MATCH_TOKEN_PREFIX})
+		assert.EqualValues(t, []string{"cream cheese", "ice cream"}, reply.Matches)
+	})
+	t.Run("autocomplete test", func(t *testing.T) {
+		server, _ := newTestServer(t, TenantQuota{}, nil, nil)
+		server.AddWords(context.Background(), &wordsearchsystemgrpc.AddWordsRequest{Words: []string{"help", "helmet"}})
+		server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "helmet"})
+		server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "helmet"})
+		server.SearchWord(context.Background(), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "hello"})
+
+		//it should complete the prefix with the most searched for words first
+		reply, err := server.Autocomplete(context.Background(), &wordsearchsystemgrpc.AutocompleteRequest{Prefix: "hel"})
+		assert.NoError(t, err)
+		if assert.Len(t, reply.Completions, 3) {
+			assert.EqualValues(t, "helmet", reply.Completions[0].Word)
+			assert.EqualValues(t, 2, reply.Completions[0].SearchCount)
+			assert.EqualValues(t, "hello", reply.Completions[1].Word)
+			assert.EqualValues(t, "help", reply.Completions[2].Word)
+			assert.True(t, reply.Completions[0].Score > reply.Completions[1].Score)
+		}
+		reply, _ = server.Autocomplete(context.Background(), &wordsearchsystemgrpc.AutocompleteRequest{Prefix: "hel", Limit: 1})
+		assert.Len(t, reply.Completions, 1)
+
+		//it should not count autocompleting as searching for the prefix
+		keyWordsReply, _ := server.Top5SearchKeyWords(context.Background(), &wordsearchsystemgrpc.Top5SearchKeyWordsRequest{})
+		assert.NotContains(t, keyWordsReply.Keywords, "hel")
+	})
+	t.Run("personalized suggestions test", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "query-log")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "queries.jsonl")
+		queryLog, err := NewQueryLog(QueryLogConfig{Path: path})
+		assert.NoError(t, err)
+		server, _ := newTestServer(t, TenantQuota{}, nil, queryLog)
+		alice := incomingContext("authorization", "Bearer acme-key", "user-id", "alice")
+		bob := incomingContext("authorization", "Bearer acme-key", "user-id", "bob")
+		admin := incomingContext("authorization", "Bearer acme-admin-key")
+		server.SearchWord(alice, &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "search"})
+		server.SearchWord(bob, &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "se"})
+		server.SearchWord(bob, &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "se"})
+
+		//it should suggest keywords from the user's own searches before every user's searches
+		reply, err := server.PersonalizedSuggestions(alice, &wordsearchsystemgrpc.PersonalizedSuggestionsRequest{Prefix: "s"})
+		assert.NoError(t, err)
+		assert.EqualValues(t, []string{"search", "se"}, reply.Suggestions)
+		reply, _ = server.PersonalizedSuggestions(bob, &wordsearchsystemgrpc.PersonalizedSuggestionsRequest{Prefix: "s", Limit: 1})
+		assert.EqualValues(t, []string{"se"}, reply.Suggestions)
+
+		//it should only let the tenant's admins erase a history, as the user-id metadata can be set by any client
+		_, err = server.EraseUserHistory(alice, &wordsearchsystemgrpc.EraseUserHistoryRequest{UserId: "alice"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = server.EraseUserHistory(context.Background(), &wordsearchsystemgrpc.EraseUserHistoryRequest{UserId: "alice"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		//it should erase the user's history, including their searches in the query log
+		_, err = server.EraseUserHistory(admin, &wordsearchsystemgrpc.EraseUserHistoryRequest{UserId: "alice"})
+		assert.NoError(t, err)
+		reply, _ = server.PersonalizedSuggestions(alice, &wordsearchsystemgrpc.PersonalizedSuggestionsRequest{Prefix: "s"})
+		assert.EqualValues(t, []string{"se", "search"}, reply.Suggestions)
+		assert.NoError(t, queryLog.Close())
+		entries := readQueryLogEntries(t, path)
+		if assert.Len(t, entries, 2) {
+			assert.EqualValues(t, "bob", entries[0].Caller)
+			assert.EqualValues(t, "bob", entries[1].Caller)
+		}
+		_, err = server.EraseUserHistory(admin, &wordsearchsystemgrpc.EraseUserHistoryRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("query refinements test", func(t *testing.T) {
+		server, _ := newTestServer(t, TenantQuota{}, nil, nil)
+		for _, session := range []string{"s1", "s2"} {
+			server.SearchWord(incomingContext("session-id", session), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "helo"})
+			server.SearchWord(incomingContext("session-id", session), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "hello"})
+		}
+		server.SearchWord(incomingContext("session-id", "s3"), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "yes"})
+		server.SearchWord(incomingContext("session-id", "s3"), &wordsearchsystemgrpc.SearchWordRequest{KeyWord: "goodbye"})
+
+		//it should list the refinements made within search sessions, the most frequent first
+		reply, err := server.ListQueryRefinements(context.Background(), &wordsearchsystemgrpc.ListQueryRefinementsRequest{})
+		assert.NoError(t, err)
+		if assert.Len(t, reply.Refinements, 2) {
+			assert.EqualValues(t, &wordsearchsystemgrpc.QueryRefinement{From: "helo", To: "hello", Count: 2, EditDistance: 1}, reply.Refinements[0])
+			assert.EqualValues(t, "goodbye", reply.Refinements[1].To)
+		}
+
+		//it should filter the refinements
+		reply, _ = server.ListQueryRefinements(context.Background(), &wordsearchsystemgrpc.ListQueryRefinementsRequest{MaxEditDistance: 2})
+		assert.Len(t, reply.Refinements, 1)
+		reply, _ = server.ListQueryRefinements(context.Background(), &wordsearchsystemgrpc.ListQueryRefinementsRequest{MinCount: 3})
+		assert.Empty(t, reply.Refinements)
+		reply, _ = server.ListQueryRefinements(context.Background(), &wordsearchsystemgrpc.ListQueryRefinementsRequest{Limit: 1})
+		assert.Len(t, reply.Refinements, 1)
+	})
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+//ValidationPolicy - the rules a word must satisfy to be added to a dictionary. Zero values do not restrict words
+type ValidationPolicy struct {
+	//AllowedCharacterClasses - unicode script, category or property names (e.g. "Latin", "Nd", "Hyphen") which every character of a word must belong to
+	AllowedCharacterClasses []string `json:"allowedCharacterClasses"`
+	MinLength               int      `json:"minLength"`
+	MaxLength               int      `json:"maxLength"`
+	//Patterns - regular expressions which every word must match
+	Patterns      []string `json:"patterns"`
+	ReservedWords []string `json:"reservedWords"`
+}
+
+//WordViolation - the reason a word was refused
+type WordViolation struct {
+	//Index - the position of the word in the request
+	Index  int
+	Word   string
+	Reason string
+}
+
+//WordValidationError - returned when one or more words are refused, with the reason for each
+type WordValidationError struct {
+	Violations []WordViolation
+}
+
+func (wordValidationError *WordValidationError) Error() string {
+	reasons := make([]string, len(wordValidationError.Violations))
+	for i, violation := range wordValidationError.Violations {
+		reasons[i] = fmt.Sprintf("%q: %s", violation.Word, violation.Reason)
+	}
+	return "invalid words: " + strings.Join(reasons, "; ")
+}
+
+//WordValidator - checks words against a ValidationPolicy. Blank words and words containing control characters are always refused
+type WordValidator struct {
+	characterClasses []*unicode.RangeTable
+	minLength        int
+	maxLength        int
+	patterns         []*regexp.Regexp
+	reservedWords    map[string]bool
+}
+
+//NewWordValidator - compiles policy into a WordValidator
+func NewWordValidator(policy ValidationPolicy) (*WordValidator, error) {
+	newWordValidator := new(WordValidator)
+	newWordValidator.minLength = policy.MinLength
+	newWordValidator.maxLength = policy.MaxLength
+	if policy.MaxLength > 0 && policy.MinLength > policy.MaxLength {
+		return nil, errors.New(fmt.Sprintf("minLength %d is greater than maxLength %d", policy.MinLength, policy.MaxLength))
+	}
+
+	for _, name := range policy.AllowedCharacterClasses {
+		characterClass := unicode.Scripts[name]
+		if characterClass == nil {
+			characterClass = unicode.Categories[name]
+		}
+		if characterClass == nil {
+			characterClass = unicode.Properties[name]
+		}
+		if characterClass == nil {
+			return nil, errors.New(fmt.Sprintf("%s is not a unicode script, category or property", name))
+		}
+		newWordValidator.characterClasses = append(newWordValidator.characterClasses, characterClass)
+	}
+
+	for _, pattern := range policy.Patterns {
+		compiledPattern, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid validation pattern")
+		}
+		newWordValidator.patterns = append(newWordValidator.patterns, compiledPattern)
+	}
+
+	newWordValidator.reservedWords = make(map[string]bool, len(policy.ReservedWords))
+	for _, reservedWord := range policy.ReservedWords {
+		newWordValidator.reservedWords[normalizeWord(reservedWord)] = true
+	}
+	return newWordValidator, nil
+}
+
+//Validate - returns the reason word is refused, or "" if it is acceptable. A nil WordValidator only applies the rules every word must satisfy
+func (wordValidator *WordValidator) Validate(word string) (reason string) {
+	if strings.TrimSpace(word) == "" {
+		return "word is blank"
+	}
+	for _, r := range word {
+		if unicode.IsControl(r) || r == utf8.RuneError {
+			return "word contains a control or invalid character"
+		}
+	}
+	if wordValidator == nil {
+		return ""
+	}
+
+	length := utf8.RuneCountInString(word)
+	if length < wordValidator.minLength {
+		return fmt.Sprintf("word is shorter than %d characters", wordValidator.minLength)
+	}
+	if wordValidator.maxLength > 0 && length > wordValidator.maxLength {
+		return fmt.Sprintf("word is longer than %d characters", wordValidator.maxLength)
+	}
+	if len(wordValidator.characterClasses) > 0 {
+		for _, r := range word {
+			if !unicode.IsOneOf(wordValidator.characterClasses, r) {
+				return fmt.Sprintf("character %q is not allowed", r)
+			}
+		}
+	}
+	for _, pattern := range wordValidator.patterns {
+		if !pattern.MatchString(word) {
+			return fmt.Sprintf("word does not match %s", pattern.String())
+		}
+	}
+	if wordValidator.reservedWords[word] {
+		return "word is reserved"
+	}
+	return ""
+}
+
+//SetWordValidator - sets the policy words must satisfy to be added to the dictionary
+func (wordDictionary *WordDictionary) SetWordValidator(wordValidator *WordValidator) {
+	wordDictionary.mutex.Lock()
+	defer wordDictionary.mutex.Unlock()
+
+	wordDictionary.wordValidator = wordValidator
+}
+
+//ValidateWords - a dry run of AddWords, returning the reason each refused word would be refused,
+// and ErrWordLimitExceeded if the words which would be accepted do not fit within the word limit. No words are added
+func (wordDictionary *WordDictionary) ValidateWords(words []string) (violations []WordViolation, err error) {
+	wordDictionary.mutex.Lock()
+	defer wordDictionary.mutex.Unlock()
+
+	violations = make([]WordViolation, 0)
+	acceptedWords := make([]string, 0, len(words))
+	for i, word := range wordDictionary.wordsToLowercase(words) {
+		reason := wordDictionary.validateWord(word)
+		if reason == "" && wordDictionary.liveEntry(word) != nil {
+			reason = "word already exists"
+		}
+		if reason != "" {
+			violations = append(violations, WordViolation{Index: i, Word: word, Reason: reason})
+			continue
+		}
+		acceptedWords = append(acceptedWords, word)
+	}
+	return violations, wordDictionary.checkRoomFor(acceptedWords)
+}
+
+//validateWord - returns the reason the lowercase word may not be added to the dictionary, or "" if it may be added
+func (wordDictionary *WordDictionary) validateWord(lowercaseWord string) (reason string) {
+	if reason = wordDictionary.wordValidator.Validate(lowercaseWord); reason != "" {
+		return reason
+	}
+	if wordDictionary.blocklist.IsBlocked(lowercaseWord) {
+		return "word is blocked"
+	}
+	return ""
+}
+
+//validateWords - checks each word against the dictionary's validation policy and blocklist. The caller must hold the mutex
+func (wordDictionary *WordDictionary) validateWords(lowercaseWords []string) (violations []WordViolation) {
+	violations = make([]WordViolation, 0)
+	for i, word := range lowercaseWords {
+		if reason := wordDictionary.validateWord(word); reason != "" {
+			violations = append(violations, WordViolation{Index: i, Word: word, Reason: reason})
+		}
+	}
+	return violations
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWordValidator(t *testing.T) {
+	t.Run("basic test", func(t *testing.T) {
+		wordValidator, err := NewWordValidator(ValidationPolicy{
+			AllowedCharacterClasses: []string{"Latin", "Pd"},
+			MinLength:               2,
+			MaxLength:               10,
+			Patterns:                []string{"^[^-]"},
+			ReservedWords:           []string{"Admin"},
+		})
+		if err != nil {
+			t.Fatalf("NewWordValidator() returned an error %+v", err)
+		}
+
+		//it should accept words which satisfy the policy
+		assert.EqualValues(t, "", wordValidator.Validate("café"))
+		assert.EqualValues(t, "", wordValidator.Validate("well-known"))
+
+		//it should refuse words which break any part of the policy
+		assert.NotEqual(t, "", wordValidator.Validate("a"))
+		assert.NotEqual(t, "", wordValidator.Validate("extraordinary"))
+		assert.NotEqual(t, "", wordValidator.Validate("abc123"))
+		assert.NotEqual(t, "", wordValidator.Validate("привет"))
+		assert.NotEqual(t, "", wordValidator.Validate("-prefix"))
+		assert.NotEqual(t, "", wordValidator.Validate("admin"))
+	})
+	t.Run("rules every word must satisfy", func(t *testing.T) {
+		var wordValidator *WordValidator
+
+		//it should refuse blank words and control characters even without a policy
+		assert.NotEqual(t, "", wordValidator.Validate(""))
+		assert.NotEqual(t, "", wordValidator.Validate("   "))
+		assert.NotEqual(t, "", wordValidator.Validate("tab\there"))
+		assert.EqualValues(t, "", wordValidator.Validate("anything😀"))
+	})
+	t.Run("invalid policy", func(t *testing.T) {
+		_, err := NewWordValidator(ValidationPolicy{AllowedCharacterClasses: []string{"Klingon"}})
+		assert.Error(t, err)
+		_, err = NewWordValidator(ValidationPolicy{Patterns: []string{"("}})
+		assert.Error(t, err)
+		_, err = NewWordValidator(ValidationPolicy{MinLength: 5, MaxLength: 2})
+		assert.Error(t, err)
+	})
+}
+
+func TestWordDictionary_Validation(t *testing.T) {
+	wordValidator, _ := NewWordValidator(ValidationPolicy{MaxLength: 5})
+	wordDictionary := NewWordDictionary("test")
+	wordDictionary.SetWordValidator(wordValidator)
+	wordDictionary.AddWords([]string{"apple"})
+
+	//it should report every refused word in a dry run without adding any words
+	violations, err := wordDictionary.ValidateWords([]string{"pear", "", "banana", "Apple"})
+	assert.NoError(t, err)
+	assert.EqualValues(t, []WordViolation{
+		{Index: 1, Word: "", Reason: "word is blank"},
+		{Index: 2, Word: "banana", Reason: "word is longer than 5 characters"},
+		{Index: 3, Word: "apple", Reason: "word already exists"},
+	}, violations)
+	assert.EqualValues(t, []string{}, wordDictionary.SearchWord("pear"))
+
+	//it should report in a dry run when the accepted words would not fit within the word limit
+	wordDictionary.SetWordLimit(3)
+	_, err = wordDictionary.ValidateWords([]string{"pear", "plum", "PEAR", "banana"})
+	assert.NoError(t, err)
+	_, err = wordDictionary.ValidateWords([]string{"pear", "plum", "fig"})
+	assert.Equal(t, ErrWordLimitExceeded, errors.Cause(err))
+	wordDictionary.SetWordLimit(0)
+
+	//it should refuse the whole AddWords request, with the reason for each invalid word
+	err = wordDictionary.AddWords([]string{"pear", "banana", "cherry"})
+	if assert.IsType(t, &WordValidationError{}, err) {
+		assert.Len(t, err.(*WordValidationError).Violations, 2)
+	}
+	assert.EqualValues(t, []string{}, wordDictionary.SearchWord("pear"))
+
+	//it should refuse the whole UpsertWords request, with the reason for each invalid word
+	_, err = wordDictionary.UpsertWords([]WordEntry{{Word: "pear"}, {Word: "banana"}, {Word: ""}, {Word: "cherry"}})
+	if assert.IsType(t, &WordValidationError{}, err) {
+		assert.EqualValues(t, []WordViolation{
+			{Index: 1, Word: "banana", Reason: "word is longer than 5 characters"},
+			{Index: 2, Word: "", Reason: "word is blank"},
+			{Index: 3, Word: "cherry", Reason: "word is longer than 5 characters"},
+		}, err.(*WordValidationError).Violations)
+	}
+	assert.EqualValues(t, []string{}, wordDictionary.SearchWord("pear"))
+}